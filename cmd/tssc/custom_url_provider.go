@@ -4,50 +4,67 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/redhat-appstudio/tssc-cli/pkg/endpoints"
+
 	"github.com/redhat-appstudio/helmet/api"
 )
 
-// CustomURLProvider implements integrations.URLProvider by building
-// GitHub App URLs from the cluster's OpenShift ingress domain.
-type CustomURLProvider struct{}
+// CustomURLProvider implements integrations.URLProvider by resolving the
+// integration module URLs from the actual Developer Hub and Pipelines-as-Code
+// hosts, derived from the cluster's ingress domain when not yet deployed.
+type CustomURLProvider struct {
+	urls  endpoints.URLsFn // the URLs the integration module declares
+	flags bool             // the module takes the URLs as flags
+}
 
-// GetCallbackURL is set to target Developer Hub
-func (CustomURLProvider) GetCallbackURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
-	ingressDomain, err := ic.GetOpenShiftIngressDomain(ctx)
+var _ api.URLProvider = (*CustomURLProvider)(nil)
+
+// NewCustomURLProvider returns a provider for the URLs the integration module
+// declares, flags tells whether the module takes the URLs as flags too.
+func NewCustomURLProvider(urls endpoints.URLsFn, flags bool) *CustomURLProvider {
+	return &CustomURLProvider{urls: urls, flags: flags}
+}
+
+// resolve resolves the URLs declared by the integration module, the flag name
+// is suggested when the URLs can't be resolved and the module takes it.
+func (p *CustomURLProvider) resolve(
+	ctx context.Context,
+	ic api.IntegrationContext,
+	flag string,
+) (*endpoints.URLs, error) {
+	urls, err := p.urls(ctx, ic)
 	if err != nil {
-		return "", fmt.Errorf("ingress domain unavailable (non-OpenShift cluster); "+
-			"provide --callback-url explicitly: %w", err)
+		if p.flags {
+			return nil, fmt.Errorf("provide --%s explicitly: %w", flag, err)
+		}
+		return nil, err
 	}
-	namespace, err := ic.GetProductNamespace("Developer Hub")
+	return urls, nil
+}
+
+// GetCallbackURL is set to target Developer Hub
+func (p *CustomURLProvider) GetCallbackURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	urls, err := p.resolve(ctx, ic, "callback-url")
 	if err != nil {
-		return "", fmt.Errorf("product unavailable: %w", err)
+		return "", err
 	}
-	return fmt.Sprintf("https://backstage-developer-hub-%s.%s/api/auth/github/handler/frame", namespace, ingressDomain), nil
+	return urls.Callback, nil
 }
 
 // GetHomepageURL is set to target Developer Hub
-func (CustomURLProvider) GetHomepageURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
-	ingressDomain, err := ic.GetOpenShiftIngressDomain(ctx)
-	if err != nil {
-		return "", fmt.Errorf("ingress domain unavailable (non-OpenShift cluster); "+
-			"provide --homepage-url explicitly: %w", err)
-	}
-	namespace, err := ic.GetProductNamespace("Developer Hub")
+func (p *CustomURLProvider) GetHomepageURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	urls, err := p.resolve(ctx, ic, "homepage-url")
 	if err != nil {
-		return "", fmt.Errorf("product unavailable: %w", err)
+		return "", err
 	}
-	return fmt.Sprintf("https://backstage-developer-hub-%s.%s", namespace, ingressDomain), nil
+	return urls.Homepage, nil
 }
 
 // GetWebhookURL is set to target Tekton Pipelines as Code
-func (CustomURLProvider) GetWebhookURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
-	ingressDomain, err := ic.GetOpenShiftIngressDomain(ctx)
+func (p *CustomURLProvider) GetWebhookURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	urls, err := p.resolve(ctx, ic, "webhook-url")
 	if err != nil {
-		return "", fmt.Errorf("ingress domain unavailable (non-OpenShift cluster); "+
-			"provide --webhook-url explicitly: %w", err)
+		return "", err
 	}
-	return fmt.Sprintf(
-		"https://pipelines-as-code-controller-openshift-pipelines.%s",
-		ingressDomain,
-	), nil
+	return urls.Webhook, nil
 }
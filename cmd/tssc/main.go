@@ -5,6 +5,10 @@ import (
 	"os"
 
 	"github.com/redhat-appstudio/tssc-cli/installer"
+	"github.com/redhat-appstudio/tssc-cli/pkg/endpoints"
+	"github.com/redhat-appstudio/tssc-cli/pkg/redact"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/subcmd"
 
 	"github.com/redhat-appstudio/helmet/api"
 	"github.com/redhat-appstudio/helmet/framework"
@@ -39,24 +43,52 @@ func main() {
 		mcpImage = fmt.Sprintf("%s:%s", mcpImage, commitID)
 	}
 
+	printDisclaimer()
+
+	// Masking the sensitive values printed, the logger, the printers and the
+	// dry-run output are written on the masking writers.
+	redactor := redact.NewRedactor()
+	stdout := redact.NewWriter(os.Stdout, redactor)
+	stderr := redact.NewWriter(os.Stderr, redactor)
+
 	// Create application runtime from embedded tarball.
 	appIntegrations := framework.StandardIntegrations()
-	appIntegrations = framework.WithURLProvider(appIntegrations, CustomURLProvider{})
+	// The GitHub App is created with the URLs, GitLab and Bitbucket show the
+	// endpoints for the user to configure.
+	githubURLs := NewCustomURLProvider(endpoints.AuthProviderURLs("github"), true)
+	gitlabURLs := NewCustomURLProvider(endpoints.AuthProviderURLs("gitlab"), false)
+	bitbucketURLs := NewCustomURLProvider(endpoints.WebhookURLs, false)
+	appIntegrations = framework.WithURLProvider(appIntegrations, githubURLs)
+	appIntegrations = framework.WithEndpoints(
+		appIntegrations, "gitlab", gitlabURLs)
+	appIntegrations = framework.WithEndpoints(
+		appIntegrations, "bitbucket", bitbucketURLs)
+	// Integration secrets written as manifests with "--secret-output".
+	secretWriter := subcmd.NewSecretWriter()
 	app, err := framework.NewAppFromTarball(
 		appCtx,
 		installer.InstallerTarball,
 		cwd,
 		framework.WithIntegrations(appIntegrations...),
 		framework.WithMCPImage(mcpImage),
+		framework.WithSecretWriter(secretWriter),
+		framework.WithOutput(stdout),
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to create application: %v\n", err)
 		os.Exit(1)
 	}
+	app.Command().SetOut(stdout)
+	app.Command().SetErr(stderr)
 
-	printDisclaimer()
+	// Registering TSSC-specific subcommands, sharing the framework global flags.
+	runCtx := runcontext.NewRunContext(app, redactor)
+	subcmd.AddCommands(app.Command(), appCtx, runCtx, secretWriter)
 
-	if err := app.Run(); err != nil {
+	err = app.Run()
+	_ = stdout.Flush()
+	_ = stderr.Flush()
+	if err != nil {
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
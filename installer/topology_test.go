@@ -0,0 +1,111 @@
+package installer
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/chartfs"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+)
+
+// TestTopology pins the order "deploy" installs the embedded charts in, resolved
+// by the framework for the default configuration and a subset of products. The
+// "tssc-iam" chart condition only selects it for the products using Keycloak.
+func TestTopology(t *testing.T) {
+	cfs := chartfs.New(os.DirFS("."))
+	charts, err := cfs.GetAllCharts()
+	if err != nil {
+		t.Fatalf("reading the charts: %v", err)
+	}
+	appCtx := api.NewAppContext("tssc")
+	collection, err := resolver.NewCollection(appCtx, charts)
+	if err != nil {
+		t.Fatalf("collecting the charts: %v", err)
+	}
+	payload, err := cfs.ReadFile(config.Filename)
+	if err != nil {
+		t.Fatalf("reading the configuration: %v", err)
+	}
+
+	tests := []struct {
+		name     string   // test case name
+		products []string // enabled products, all when empty
+		want     []string // charts in deployment order
+	}{{
+		name: "default",
+		want: []string{
+			"tssc-openshift",
+			"tssc-subscriptions",
+			"tssc-acs",
+			"tssc-gitops",
+			"tssc-infrastructure",
+			"tssc-iam",
+			"tssc-tas",
+			"tssc-pipelines-config",
+			"tssc-pipelines",
+			"tssc-tpa",
+			"tssc-app-namespaces",
+			"tssc-dh",
+			"tssc-integrations",
+			"tssc-acs-test",
+		},
+	}, {
+		name:     "advanced cluster security",
+		products: []string{"Advanced Cluster Security"},
+		want: []string{
+			"tssc-openshift",
+			"tssc-subscriptions",
+			"tssc-acs",
+			"tssc-app-namespaces",
+			"tssc-infrastructure",
+			"tssc-integrations",
+			"tssc-pipelines-config",
+			"tssc-acs-test",
+		},
+	}, {
+		name:     "trusted artifact signer",
+		products: []string{"Trusted Artifact Signer"},
+		want: []string{
+			"tssc-openshift",
+			"tssc-subscriptions",
+			"tssc-infrastructure",
+			"tssc-iam",
+			"tssc-tas",
+			"tssc-pipelines-config",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.NewConfigFromBytes(
+				payload, appCtx.Namespace, appCtx.IdentifierName())
+			if err != nil {
+				t.Fatalf("parsing the configuration: %v", err)
+			}
+			for _, product := range cfg.Installer.Products {
+				if len(tt.products) == 0 {
+					break
+				}
+				product.Enabled = slices.Contains(tt.products, product.Name)
+				if err = cfg.SetProduct(product.Name, product); err != nil {
+					t.Fatalf("setting product %q: %v", product.Name, err)
+				}
+			}
+
+			topology := resolver.NewTopology()
+			err = resolver.NewResolver(cfg, collection, topology).Resolve()
+			if err != nil {
+				t.Fatalf("resolving the topology: %v", err)
+			}
+			names := []string{}
+			for _, d := range topology.Dependencies() {
+				names = append(names, d.Name())
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("topology order:\n got: %v\nwant: %v", names, tt.want)
+			}
+		})
+	}
+}
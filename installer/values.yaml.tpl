@@ -6,12 +6,22 @@
 {{- $pipelines := required "Pipelines settings" .Installer.Products.OpenShift_Pipelines -}}
 {{- $pipelinesNamespace := "openshift-pipelines" -}}
 {{- $rhdh := required "RHDH settings" .Installer.Products.Developer_Hub -}}
-{{- $ingressDomain := required "OpenShift ingress domain" .OpenShift.Ingress.Domain -}}
-{{- $ingressRouterCA := required "OpenShift RouterCA" .OpenShift.Ingress.RouterCA -}}
-{{- $openshiftMinorVersion := required "OpenShift Version" .OpenShift.MinorVersion -}}
+{{- $ingressDomain := required "Cluster ingress domain, set the ingressDomain setting" .Cluster.Ingress.Domain -}}
+{{- $ingressRouterCA := "" -}}
+{{- if .Cluster.IsOpenShift }}
+  {{- $ingressRouterCA = required "OpenShift RouterCA" .OpenShift.Ingress.RouterCA -}}
+  {{- $_ := required "OpenShift Version" .OpenShift.MinorVersion -}}
+{{- end }}
 {{- $authProvider := required "Auth Provider is required" $rhdh.Properties.authProvider }}
-{{- $keycloakEnabled := or $tpa.Enabled $tas.Enabled (and $rhdh.Enabled (eq $authProvider "oidc"))}}
+{{- $charts := required "Charts deployed" .Installer.Charts }}
+{{- /* Keycloak is deployed when the "tssc-iam" chart condition is met. */}}
+{{- $keycloakEnabled := has "tssc-iam" $charts }}
 {{- $keycloakNamespace := "tssc-keycloak" -}}
+{{- $keycloakRouteHost := printf "sso.%s" $ingressDomain }}
+{{- with .Installer.Instance }}
+  {{- $keycloakNamespace = printf "%s-%s" $keycloakNamespace . }}
+  {{- $keycloakRouteHost = printf "sso-%s.%s" . $ingressDomain }}
+{{- end }}
 ---
 debug:
   ci: {{ dig "ci" "debug" false .Installer.Settings }}
@@ -77,6 +87,10 @@ subscriptions:
   trustedProfileAnalyzer:
     enabled: {{ $tpa.Enabled }}
     managed: {{ and $tpa.Enabled $tpa.Properties.manageSubscription }}
+    namespace: {{ $tpa.Namespace }}
+    operatorGroup:
+      targetNamespaces:
+        - {{ $tpa.Namespace }}
   advancedClusterSecurity:
     enabled: {{ $acs.Enabled }}
     managed: {{ and $acs.Enabled $acs.Properties.manageSubscription }}
@@ -108,7 +122,6 @@ infrastructure:
 #
 
 {{- $keycloakRouteTLSSecretName := "keycloak-tls" }}
-{{- $keycloakRouteHost := printf "sso.%s" $ingressDomain }}
 {{- $realmsName := "tssc-iam" }}
 {{- $tpaTestingUsersEnabled := false }}
 {{- $protocol := "https" -}}
@@ -116,6 +129,11 @@ infrastructure:
   {{- $protocol = "http" }}
 {{- end }}
 {{- $tpaOIDCIssuerURL := printf "%s://%s/realms/%s" $protocol $keycloakRouteHost $realmsName }}
+{{- $rhdhHost := printf "backstage-developer-hub-%s.%s" $rhdh.Namespace $ingressDomain }}
+{{- $rhdhRoute := lookup "route.openshift.io/v1" "Route" $rhdh.Namespace "backstage-developer-hub" }}
+{{- if $rhdhRoute }}
+  {{- $rhdhHost = $rhdhRoute.spec.host }}
+{{- end }}
 
 iam:
   enabled: {{ $keycloakEnabled }}
@@ -146,17 +164,9 @@ iam:
     rhdhRealm:
       enabled: {{ and $rhdh.Enabled (eq $authProvider "oidc") }}
       rhdhRedirectUris:
-        - {{
-          printf "%s://backstage-developer-hub-tssc-dh.%s/api/auth/oidc/handler/frame"
-          $protocol
-          $ingressDomain
-        }}
+        - {{ printf "%s://%s/api/auth/oidc/handler/frame" $protocol $rhdhHost }}
       rhdhOriginUris:
-        - {{
-          printf "%s://backstage-developer-hub-tssc-dh.%s"
-          $protocol
-          $ingressDomain
-        }}
+        - {{ printf "%s://%s" $protocol $rhdhHost }}
     trustedArtifactSignerRealm:
       enabled: {{ $tas.Enabled }}
     trustedProfileAnalyzerRealm:
@@ -0,0 +1,269 @@
+// Package charttest is a golden-file test harness for the installer charts. A
+// suite directory holds a case per subdirectory, each case with an installer
+// configuration file, the whole topology is rendered offline and compared with
+// the golden manifests checked in on the case directory:
+//
+//	suite/
+//	  facts.yaml             # OpenShift facts shared by the cases
+//	  fixtures/              # objects served to "lookup", shared by the cases
+//	  <case>/config.yaml     # installer configuration
+//	  <case>/facts.yaml      # optional, replaces the suite's facts
+//	  <case>/fixtures/       # optional, added to the suite's fixtures
+//	  <case>/golden/         # rendered manifests, a directory per chart
+//
+// The rendered manifests are also asserted against the suite invariants. It's
+// used by "tssc chart test", and on Go tests:
+//
+//	suite := charttest.NewSuite(logger, chartfs.New(os.DirFS("installer")),
+//		"tssc", "tssc", "test/charts")
+//	result, err := suite.Run(ctx, "default", false)
+package charttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/offline"
+	"github.com/redhat-appstudio/tssc-cli/pkg/render"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/chartfs"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/engine"
+	"github.com/redhat-appstudio/helmet/framework"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// factsFile the OpenShift facts file, on the suite and case directories.
+	factsFile = "facts.yaml"
+	// fixturesDir the lookup fixtures directory, on the suite and case
+	// directories.
+	fixturesDir = "fixtures"
+	// goldenDir the golden manifests directory, on the case directory.
+	goldenDir = "golden"
+)
+
+// ErrInvalidSuite the suite directory layout is invalid.
+var ErrInvalidSuite = errors.New("invalid chart test suite")
+
+// Suite the golden-file test suite for the installer charts.
+type Suite struct {
+	logger    *slog.Logger     // application logger
+	cfs       *chartfs.ChartFS // installer filesystem
+	appName   string           // application name
+	namespace string           // installer namespace
+	dir       string           // suite directory
+
+	// Instance the installation instance the cases are rendered as, the
+	// default installation when empty.
+	Instance string
+	// Invariants asserted on every case, DefaultInvariants by default.
+	Invariants []Invariant
+}
+
+// Cases returns the suite case names, the subdirectories with a configuration
+// file, sorted.
+func (s *Suite) Cases() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	cases := []string{}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err = os.Stat(filepath.Join(s.dir, e.Name(), config.Filename)); err == nil {
+			cases = append(cases, e.Name())
+		}
+	}
+	if len(cases) == 0 {
+		return nil, fmt.Errorf("%w: no cases with %q found on %q",
+			ErrInvalidSuite, config.Filename, s.dir)
+	}
+	slices.Sort(cases)
+	return cases, nil
+}
+
+// facts returns the case OpenShift facts, the case's own file takes precedence.
+func (s *Suite) facts(caseDir string) (*offline.OpenShiftFacts, error) {
+	for _, path := range []string{
+		filepath.Join(caseDir, factsFile),
+		filepath.Join(s.dir, factsFile),
+	} {
+		if _, err := os.Stat(path); err == nil {
+			return offline.LoadFacts(path)
+		}
+	}
+	return nil, fmt.Errorf("%w: %q not found for case %q",
+		ErrInvalidSuite, factsFile, filepath.Base(caseDir))
+}
+
+// objects returns the objects served offline for the case: the installer
+// configuration, the OpenShift facts, the suite and the case fixtures.
+func (s *Suite) objects(
+	caseDir string,
+	payload []byte,
+	facts *offline.OpenShiftFacts,
+) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{
+		offline.ConfigObject(s.appName, s.Instance, s.namespace, payload),
+	}
+	factsObjects, err := offline.FactsObjects(facts)
+	if err != nil {
+		return nil, err
+	}
+	objects = append(objects, factsObjects...)
+	for _, dir := range []string{
+		filepath.Join(s.dir, fixturesDir),
+		filepath.Join(caseDir, fixturesDir),
+	} {
+		if _, err = os.Stat(dir); err != nil {
+			continue
+		}
+		fixtures, err := offline.LoadFixtures(dir)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, fixtures...)
+	}
+	return objects, nil
+}
+
+// Render renders the case topology offline, in order.
+func (s *Suite) Render(ctx context.Context, name string) ([]*render.Release, error) {
+	caseDir := filepath.Join(s.dir, name)
+	payload, err := os.ReadFile(filepath.Join(caseDir, config.Filename))
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.NewConfigFromBytes(
+		payload, s.namespace, strings.ReplaceAll(s.appName, "-", "_"))
+	if err != nil {
+		return nil, err
+	}
+	if s.Instance != "" {
+		if err = cfg.ScopeNamespaces(s.Instance); err != nil {
+			return nil, err
+		}
+		payload = []byte(cfg.String())
+	}
+	facts, err := s.facts(caseDir)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := s.objects(caseDir, payload, facts)
+	if err != nil {
+		return nil, err
+	}
+	kube := offline.NewKube(objects...)
+
+	charts, err := s.cfs.GetAllCharts()
+	if err != nil {
+		return nil, err
+	}
+	manager, err := framework.NewIntegrations(s.appName, &api.RunContext{
+		Kube:    kube,
+		ChartFS: s.cfs,
+		Flags:   &api.Flags{},
+		Logger:  s.logger,
+		Out:     io.Discard,
+	}, framework.StandardIntegrations()...)
+	if err != nil {
+		return nil, err
+	}
+	configured, err := integrations.Configured(ctx, manager, cfg)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := topology.ResolveCharts(
+		api.NewAppContext(s.appName), cfg, charts, configured)
+	if err != nil {
+		return nil, err
+	}
+	valuesTmpl, err := s.cfs.ReadFile(engine.ValuesFilename)
+	if err != nil {
+		return nil, err
+	}
+	values, err := render.Values(
+		ctx, s.logger, kube, cfg, resolved, string(valuesTmpl))
+	if err != nil {
+		return nil, err
+	}
+	return render.NewRenderer(
+		s.logger, kube, &api.Flags{Instance: s.Instance}, values).
+		RenderTopology(ctx, resolved)
+}
+
+// Run renders the case, masking the generated values, asserts the invariants
+// and compares the manifests with the golden files. With update, the golden
+// files are rewritten instead of compared.
+func (s *Suite) Run(ctx context.Context, name string, update bool) (*Result, error) {
+	s.logger.Debug("Rendering the chart test case", "case", name)
+	releases, err := s.Render(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("case %q: %w", name, err)
+	}
+	// Rendering again, the values generated on each rendering, passwords and
+	// alike, differ between both, and are masked.
+	again, err := s.Render(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("case %q: %w", name, err)
+	}
+	files := maskGenerated(render.Tree(releases), render.Tree(again))
+
+	result := &Result{Case: name}
+	for _, invariant := range s.Invariants {
+		for _, violation := range invariant.Check(releases) {
+			result.Violations = append(result.Violations,
+				fmt.Sprintf("%s: %s", invariant.Name, violation))
+		}
+	}
+
+	golden := filepath.Join(s.dir, name, goldenDir)
+	if update {
+		if err = os.RemoveAll(golden); err != nil {
+			return nil, err
+		}
+		if err = render.WriteFiles(golden, files); err != nil {
+			return nil, err
+		}
+		result.Updated = true
+		return result, nil
+	}
+	expected, err := readFiles(golden)
+	if err != nil {
+		return nil, fmt.Errorf("case %q: reading golden files, use update: %w",
+			name, err)
+	}
+	result.Diffs = compare(expected, files)
+	return result, nil
+}
+
+// NewSuite instantiates the suite on the informed directory, rendering the
+// installer filesystem charts for the application name and installer
+// namespace.
+func NewSuite(
+	logger *slog.Logger,
+	cfs *chartfs.ChartFS,
+	appName, namespace, dir string,
+) *Suite {
+	return &Suite{
+		logger:     logger,
+		cfs:        cfs,
+		appName:    appName,
+		namespace:  namespace,
+		dir:        dir,
+		Invariants: DefaultInvariants(namespace),
+	}
+}
@@ -0,0 +1,127 @@
+package charttest
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api/chartfs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TestSuite renders the installer charts test suite cases, comparing with the
+// golden manifests and asserting the invariants, as "tssc chart test" does.
+func TestSuite(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfs := chartfs.New(os.DirFS("../../installer"))
+	suite := NewSuite(logger, cfs, "tssc", "tssc", "../../test/charts")
+
+	cases, err := suite.Cases()
+	if err != nil {
+		t.Fatalf("listing the cases: %v", err)
+	}
+	if len(cases) == 0 {
+		t.Fatal("no chart test cases found")
+	}
+
+	for _, name := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := suite.Run(context.TODO(), name, false)
+			if err != nil {
+				t.Fatalf("running the case: %v", err)
+			}
+			if result.Failed() {
+				t.Errorf("case %q failed:\n%s", name, result)
+			}
+		})
+	}
+}
+
+// copyFile copies the file on the destination, creating its directory, the
+// replacer rewrites the payload.
+func copyFile(t *testing.T, src, dst string, r *strings.Replacer) {
+	t.Helper()
+	payload, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatalf("reading %s: %v", src, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		t.Fatalf("creating %s: %v", filepath.Dir(dst), err)
+	}
+	if err = os.WriteFile(dst, []byte(r.Replace(string(payload))), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", dst, err)
+	}
+}
+
+// instanceFootprint renders the default case as the installation instance,
+// with the fixtures on its installer namespace, returning the namespaces and
+// the route hosts the releases use.
+func instanceFootprint(t *testing.T, instance string) map[string]bool {
+	t.Helper()
+	namespace := "tssc-" + instance
+	dir := t.TempDir()
+	none := strings.NewReplacer()
+	copyFile(t, "../../test/charts/facts.yaml",
+		filepath.Join(dir, "facts.yaml"), none)
+	copyFile(t, "../../test/charts/default/config.yaml",
+		filepath.Join(dir, "default", "config.yaml"), none)
+	copyFile(t, "../../test/charts/fixtures/quay-integration.yaml",
+		filepath.Join(dir, "fixtures", "quay-integration.yaml"),
+		strings.NewReplacer("namespace: tssc\n", "namespace: "+namespace+"\n"))
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	suite := NewSuite(logger, chartfs.New(os.DirFS("../../installer")),
+		"tssc", namespace, dir)
+	suite.Instance = instance
+	releases, err := suite.Render(context.TODO(), "default")
+	if err != nil {
+		t.Fatalf("rendering instance %q: %v", instance, err)
+	}
+	if violations := NamespacesDeclared(namespace)(releases); len(violations) > 0 {
+		t.Errorf("instance %q namespaces not declared: %v", instance, violations)
+	}
+
+	footprint := map[string]bool{}
+	for _, rel := range releases {
+		footprint["namespace "+rel.Namespace] = true
+	}
+	for _, o := range objects(releases) {
+		if ns := o.obj.GetNamespace(); ns != "" {
+			footprint["namespace "+ns] = true
+		}
+		if o.obj.GetKind() == "Route" {
+			host, _, _ := unstructured.NestedString(o.obj.Object, "spec", "host")
+			footprint["host "+host] = true
+		}
+	}
+	return footprint
+}
+
+// TestSuite_Instances renders two installation instances, asserting they share
+// neither namespaces nor route hosts, besides the operators' and OpenShift's.
+func TestSuite_Instances(t *testing.T) {
+	shared := func(key string) bool {
+		ns := strings.TrimPrefix(key, "namespace ")
+		return ns != key && (strings.HasPrefix(ns, "openshift-") ||
+			strings.HasSuffix(ns, "-operator"))
+	}
+	blue := instanceFootprint(t, "blue")
+	green := instanceFootprint(t, "green")
+	for key := range blue {
+		if green[key] && !shared(key) {
+			t.Errorf("instances share %s", key)
+		}
+	}
+	for _, want := range []string{
+		"namespace tssc-keycloak-blue",
+		"host sso-blue.apps.example.com",
+	} {
+		if !blue[want] {
+			t.Errorf("instance %q lacks %s", "blue", want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package charttest
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// generatedMask replaces the values generated on each rendering.
+const generatedMask = "<generated>"
+
+// Result the outcome of a chart test case.
+type Result struct {
+	Case       string   // case name
+	Updated    bool     // golden files rewritten
+	Diffs      []string // golden files differences, unified diffs
+	Violations []string // invariants violations
+}
+
+// Failed checks whether the case failed.
+func (r *Result) Failed() bool {
+	return len(r.Diffs) > 0 || len(r.Violations) > 0
+}
+
+// String describes the case differences and violations.
+func (r *Result) String() string {
+	var b strings.Builder
+	for _, v := range r.Violations {
+		fmt.Fprintf(&b, "  - %s\n", v)
+	}
+	for _, d := range r.Diffs {
+		b.WriteString(d)
+	}
+	return b.String()
+}
+
+// isTokenChar checks whether the character belongs to a generated token, an
+// alphanumeric string, possibly base64 encoded.
+func isTokenChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '+' || c == '/' || c == '='
+}
+
+// maskLine masks the token differing between both lines, widened to the token
+// boundaries, so partially matching random values are masked whole.
+func maskLine(a, b string) string {
+	start := 0
+	for start < len(a) && start < len(b) && a[start] == b[start] {
+		start++
+	}
+	end := 0
+	for end < len(a)-start && end < len(b)-start &&
+		a[len(a)-1-end] == b[len(b)-1-end] {
+		end++
+	}
+	stop := len(a) - end
+	for start > 0 && isTokenChar(a[start-1]) {
+		start--
+	}
+	for stop < len(a) && isTokenChar(a[stop]) {
+		stop++
+	}
+	return a[:start] + generatedMask + a[stop:]
+}
+
+// maskGenerated masks the values generated on each rendering, the lines differing
+// between two renderings of the same case, so the golden files are stable.
+func maskGenerated(files, again map[string]string) map[string]string {
+	masked := map[string]string{}
+	for name, payload := range files {
+		lines := strings.Split(payload, "\n")
+		other := strings.Split(again[name], "\n")
+		if len(lines) == len(other) {
+			for i := range lines {
+				if lines[i] != other[i] {
+					lines[i] = maskLine(lines[i], other[i])
+				}
+			}
+		}
+		masked[name] = strings.Join(lines, "\n")
+	}
+	return masked
+}
+
+// readFiles reads the files on the directory, by relative path.
+func readFiles(dir string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		payload, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = string(payload)
+		return nil
+	})
+	return files, err
+}
+
+// compare returns the unified diffs between the golden and rendered files, by
+// file, sorted.
+func compare(golden, rendered map[string]string) []string {
+	names := []string{}
+	for name := range golden {
+		names = append(names, name)
+	}
+	for name := range rendered {
+		if _, ok := golden[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	diffs := []string{}
+	for _, name := range names {
+		if golden[name] == rendered[name] {
+			continue
+		}
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(golden[name]),
+			B:        difflib.SplitLines(rendered[name]),
+			FromFile: filepath.Join(goldenDir, name),
+			ToFile:   name + " (rendered)",
+			Context:  3,
+		})
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
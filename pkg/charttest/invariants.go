@@ -0,0 +1,146 @@
+package charttest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/render"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Invariant an assertion on the rendered releases, returning the violations.
+type Invariant struct {
+	Name  string                                    // invariant name
+	Check func(releases []*render.Release) []string // assertion
+}
+
+// object a rendered object, and the release rendering it.
+type object struct {
+	release string                     // release name
+	obj     *unstructured.Unstructured // rendered object
+}
+
+// String identifies the object on the violations.
+func (o *object) String() string {
+	return fmt.Sprintf("%s: %s %q", o.release, o.obj.GetKind(), o.obj.GetName())
+}
+
+// objects decodes the releases manifests and hooks into objects.
+func objects(releases []*render.Release) []object {
+	objs := []object{}
+	for _, rel := range releases {
+		payloads := []string{rel.Manifest}
+		for _, h := range rel.Hooks {
+			payloads = append(payloads, h.Manifest)
+		}
+		for _, payload := range payloads {
+			decoder := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(payload), 4096)
+			for {
+				u := map[string]any{}
+				if err := decoder.Decode(&u); err != nil {
+					if !errors.Is(err, io.EOF) {
+						objs = append(objs, object{
+							release: rel.Name,
+							obj: &unstructured.Unstructured{Object: map[string]any{
+								"kind": "invalid YAML: " + err.Error(),
+							}},
+						})
+					}
+					break
+				}
+				if len(u) > 0 {
+					objs = append(objs, object{
+						release: rel.Name,
+						obj:     &unstructured.Unstructured{Object: u},
+					})
+				}
+			}
+		}
+	}
+	return objs
+}
+
+// images walks the object attributes collecting the "image" string values.
+func images(v any, fn func(image string)) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if image, ok := child.(string); ok && k == "image" {
+				fn(image)
+				continue
+			}
+			images(child, fn)
+		}
+	case []any:
+		for _, child := range t {
+			images(child, fn)
+		}
+	}
+}
+
+// ImageTags asserts the container images are not empty, neither carry an empty
+// tag, as when the tag value is missing.
+func ImageTags(releases []*render.Release) []string {
+	violations := []string{}
+	for _, o := range objects(releases) {
+		images(o.obj.Object, func(image string) {
+			image = strings.TrimSpace(image)
+			if image == "" || strings.HasSuffix(image, ":") ||
+				strings.Contains(image, ":@") {
+				violations = append(violations,
+					fmt.Sprintf("%s: empty image tag %q", o.String(), image))
+			}
+		})
+	}
+	slices.Sort(violations)
+	return violations
+}
+
+// NamespacesDeclared asserts the namespaces the objects and releases target are
+// declared: projects requested by the "<app>-openshift" chart, or namespaces
+// created by the charts. The installer namespace and the OpenShift platform
+// namespaces are expected to exist.
+func NamespacesDeclared(namespace string) func([]*render.Release) []string {
+	return func(releases []*render.Release) []string {
+		declared := map[string]bool{namespace: true}
+		for _, o := range objects(releases) {
+			switch o.obj.GetKind() {
+			case "Namespace", "Project", "ProjectRequest":
+				declared[o.obj.GetName()] = true
+			}
+		}
+		isDeclared := func(ns string) bool {
+			return ns == "" || declared[ns] || strings.HasPrefix(ns, "openshift-")
+		}
+
+		violations := []string{}
+		for _, rel := range releases {
+			if !isDeclared(rel.Namespace) {
+				violations = append(violations, fmt.Sprintf(
+					"%s: release namespace %q is not declared", rel.Name, rel.Namespace))
+			}
+		}
+		for _, o := range objects(releases) {
+			if ns := o.obj.GetNamespace(); !isDeclared(ns) {
+				violations = append(violations, fmt.Sprintf(
+					"%s: namespace %q is not declared", o.String(), ns))
+			}
+		}
+		slices.Sort(violations)
+		return slices.Compact(violations)
+	}
+}
+
+// DefaultInvariants the invariants asserted by default, for the installer
+// namespace.
+func DefaultInvariants(namespace string) []Invariant {
+	return []Invariant{
+		{Name: "image-tags", Check: ImageTags},
+		{Name: "namespaces-declared", Check: NamespacesDeclared(namespace)},
+	}
+}
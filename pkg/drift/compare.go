@@ -0,0 +1,228 @@
+package drift
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/redact"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Field represents a field-level difference between the release manifest and
+// the live object.
+type Field struct {
+	Path     string // field path, e.g. "spec.replicas"
+	Expected any    // value on the release manifest
+	Live     any    // value on the cluster, nil when absent
+	Absent   bool   // the field is absent on the live object
+}
+
+// ignoredFields top-level fields populated, or owned, by the API server.
+var ignoredFields = map[string]bool{
+	"status": true,
+}
+
+// ignoredMetadata metadata fields populated by the API server.
+var ignoredMetadata = map[string]bool{
+	"creationTimestamp": true,
+	"generation":        true,
+	"managedFields":     true,
+	"resourceVersion":   true,
+	"selfLink":          true,
+	"uid":               true,
+}
+
+// plainKeyRe matches keys that can be used on a dotted path as is.
+var plainKeyRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// quantityPathRe matches the paths holding resource quantities, the entries of
+// container resources, quotas, limit ranges and capacities, e.g.
+// "spec.containers[0].resources.limits.cpu".
+var quantityPathRe = regexp.MustCompile(
+	`(^|\.)(limits|requests|hard|capacity|allocatable|default|defaultRequest|max|min)` +
+		`(\.[a-zA-Z0-9_-]+|\["[^"]*"\])$`)
+
+// childPath appends the map key to the informed path.
+func childPath(path, key string) string {
+	if !plainKeyRe.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// isEmpty asserts whether the value is null or an empty collection, the API
+// server omits those when the object is stored.
+func isEmpty(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(t) == 0
+	case []any:
+		return len(t) == 0
+	case string:
+		return t == ""
+	}
+	return false
+}
+
+// equalScalar compares scalar values, numbers are compared regardless of the
+// type they were decoded as.
+func equalScalar(a, b any) bool {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toQuantity converts the string, or number, to a resource quantity.
+func toQuantity(v any) (resource.Quantity, bool) {
+	if f, ok := toFloat(v); ok {
+		v = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(s)
+	return q, err == nil
+}
+
+// equalQuantity compares resource quantities regardless of the notation, the
+// API server stores them canonical, e.g. "1000m" as "1", and "1024Mi" as "1Gi".
+func equalQuantity(a, b any) bool {
+	qa, ok := toQuantity(a)
+	if !ok {
+		return false
+	}
+	qb, ok := toQuantity(b)
+	return ok && qa.Cmp(qb) == 0
+}
+
+// toFloat converts numeric values to float64.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// sortedKeys returns the map keys in order, for a stable report.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// compare walks the expected value, from the release manifest, comparing it
+// with the live value. Only the fields on the manifest are compared, the fields
+// added by the API server, admission webhooks and controllers are not drift.
+func compare(path string, expected, live any, fields []Field) []Field {
+	if isEmpty(expected) && isEmpty(live) {
+		return fields
+	}
+	switch e := expected.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return append(fields, Field{Path: path, Expected: e, Live: live})
+		}
+		for _, k := range sortedKeys(e) {
+			p := childPath(path, k)
+			if lv, found := l[k]; found || isEmpty(e[k]) {
+				fields = compare(p, e[k], lv, fields)
+				continue
+			}
+			fields = append(fields, Field{Path: p, Expected: e[k], Absent: true})
+		}
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(e) {
+			return append(fields, Field{Path: path, Expected: e, Live: live})
+		}
+		for i := range e {
+			fields = compare(fmt.Sprintf("%s[%d]", path, i), e[i], l[i], fields)
+		}
+	default:
+		if equalScalar(expected, live) ||
+			(quantityPathRe.MatchString(path) && equalQuantity(expected, live)) {
+			return fields
+		}
+		fields = append(fields, Field{Path: path, Expected: expected, Live: live})
+	}
+	return fields
+}
+
+// compareObjects compares the release manifest object with the live object,
+// skipping the fields populated by the API server.
+func compareObjects(expected, live map[string]any) []Field {
+	filtered := map[string]any{}
+	for k, v := range expected {
+		if !ignoredFields[k] {
+			filtered[k] = v
+		}
+	}
+	if metadata, ok := expected["metadata"].(map[string]any); ok {
+		filteredMetadata := map[string]any{}
+		for k, v := range metadata {
+			if !ignoredMetadata[k] {
+				filteredMetadata[k] = v
+			}
+		}
+		filtered["metadata"] = filteredMetadata
+	}
+	return compare("", filtered, live, []Field{})
+}
+
+// normalizeSecret moves the "stringData" entries into "data", base64 encoded,
+// the same as the API server does when the Secret is stored.
+func normalizeSecret(obj map[string]any) {
+	stringData, ok := obj["stringData"].(map[string]any)
+	if !ok {
+		return
+	}
+	data, ok := obj["data"].(map[string]any)
+	if !ok {
+		data = map[string]any{}
+	}
+	for k, v := range stringData {
+		data[k] = base64.StdEncoding.EncodeToString(fmt.Append(nil, v))
+	}
+	obj["data"] = data
+	delete(obj, "stringData")
+}
+
+// redactFields hides the Secret payload values from the report, only the path
+// of the drifted entries is shown.
+func redactFields(fields []Field) {
+	for i := range fields {
+		if fields[i].Expected != nil {
+			fields[i].Expected = redact.Placeholder
+		}
+		if fields[i].Live != nil {
+			fields[i].Live = redact.Placeholder
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package drift
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/redact"
+)
+
+// container the Deployment with a single container, with the informed resources.
+func container(resources map[string]any) map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "app", "namespace": "tssc"},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":      "app",
+						"resources": resources,
+					}},
+				},
+			},
+		},
+	}
+}
+
+// limits the container resources with the informed limits.
+func limits(entries map[string]any) map[string]any {
+	return map[string]any{"limits": entries}
+}
+
+// limitsPath the path of the container resource limit.
+const limitsPath = "spec.template.spec.containers[0].resources.limits."
+
+func TestCompareObjects(t *testing.T) {
+	tests := []struct {
+		name     string         // test case name
+		expected map[string]any // release manifest object
+		live     map[string]any // live object
+		want     []Field        // drift expected
+	}{{
+		name:     "equal",
+		expected: container(limits(map[string]any{"cpu": "1"})),
+		live:     container(limits(map[string]any{"cpu": "1"})),
+		want:     []Field{},
+	}, {
+		name: "fields populated by the api server",
+		expected: map[string]any{
+			"metadata": map[string]any{"name": "app"},
+			"status":   map[string]any{"ready": true},
+		},
+		live: map[string]any{
+			"metadata": map[string]any{
+				"name":            "app",
+				"uid":             "5d1c",
+				"resourceVersion": "42",
+				"annotations":     map[string]any{"added": "by a controller"},
+			},
+			"status": map[string]any{"ready": false},
+		},
+		want: []Field{},
+	}, {
+		name:     "changed scalar",
+		expected: map[string]any{"spec": map[string]any{"replicas": int64(1)}},
+		live:     map[string]any{"spec": map[string]any{"replicas": int64(3)}},
+		want: []Field{
+			{Path: "spec.replicas", Expected: int64(1), Live: int64(3)},
+		},
+	}, {
+		name:     "numbers decoded as different types",
+		expected: map[string]any{"spec": map[string]any{"replicas": float64(2)}},
+		live:     map[string]any{"spec": map[string]any{"replicas": int64(2)}},
+		want:     []Field{},
+	}, {
+		name:     "absent field",
+		expected: map[string]any{"data": map[string]any{"key": "value"}},
+		live:     map[string]any{"data": map[string]any{}},
+		want:     []Field{{Path: "data.key", Expected: "value", Absent: true}},
+	}, {
+		name:     "empty values omitted by the api server",
+		expected: map[string]any{"metadata": map[string]any{"labels": map[string]any{}}},
+		live:     map[string]any{"metadata": map[string]any{}},
+		want:     []Field{},
+	}, {
+		name:     "list length",
+		expected: map[string]any{"args": []any{"--a", "--b"}},
+		live:     map[string]any{"args": []any{"--a"}},
+		want: []Field{{
+			Path: "args", Expected: []any{"--a", "--b"}, Live: []any{"--a"},
+		}},
+	}, {
+		name:     "dotted key",
+		expected: map[string]any{"labels": map[string]any{"app.kubernetes.io/name": "a"}},
+		live:     map[string]any{"labels": map[string]any{"app.kubernetes.io/name": "b"}},
+		want: []Field{
+			{Path: `labels["app.kubernetes.io/name"]`, Expected: "a", Live: "b"},
+		},
+	}, {
+		name:     "cpu millicores",
+		expected: container(limits(map[string]any{"cpu": "1000m"})),
+		live:     container(limits(map[string]any{"cpu": "1"})),
+		want:     []Field{},
+	}, {
+		name:     "cpu as number",
+		expected: container(limits(map[string]any{"cpu": int64(2)})),
+		live:     container(limits(map[string]any{"cpu": "2"})),
+		want:     []Field{},
+	}, {
+		name:     "memory binary suffixes",
+		expected: container(limits(map[string]any{"memory": "1024Mi"})),
+		live:     container(limits(map[string]any{"memory": "1Gi"})),
+		want:     []Field{},
+	}, {
+		name:     "extended resource",
+		expected: container(limits(map[string]any{"nvidia.com/gpu": "1"})),
+		live:     container(limits(map[string]any{"nvidia.com/gpu": "1000m"})),
+		want:     []Field{},
+	}, {
+		name: "storage request",
+		expected: map[string]any{"spec": map[string]any{"resources": map[string]any{
+			"requests": map[string]any{"storage": "2048Mi"},
+		}}},
+		live: map[string]any{"spec": map[string]any{"resources": map[string]any{
+			"requests": map[string]any{"storage": "2Gi"},
+		}}},
+		want: []Field{},
+	}, {
+		name:     "changed quantity",
+		expected: container(limits(map[string]any{"cpu": "500m"})),
+		live:     container(limits(map[string]any{"cpu": "1"})),
+		want: []Field{
+			{Path: limitsPath + "cpu", Expected: "500m", Live: "1"},
+		},
+	}, {
+		name:     "quantity notation outside of resources",
+		expected: map[string]any{"data": map[string]any{"size": "1000m"}},
+		live:     map[string]any{"data": map[string]any{"size": "1"}},
+		want: []Field{
+			{Path: "data.size", Expected: "1000m", Live: "1"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareObjects(tt.expected, tt.live)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compareObjects() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSecret(t *testing.T) {
+	obj := map[string]any{
+		"data":       map[string]any{"a": "YQ=="},
+		"stringData": map[string]any{"b": "b"},
+	}
+	normalizeSecret(obj)
+	want := map[string]any{"data": map[string]any{
+		"a": "YQ==",
+		"b": base64.StdEncoding.EncodeToString([]byte("b")),
+	}}
+	if !reflect.DeepEqual(obj, want) {
+		t.Errorf("normalizeSecret() = %#v, want %#v", obj, want)
+	}
+}
+
+func TestRedactFields(t *testing.T) {
+	fields := []Field{
+		{Path: "data.token", Expected: "bmV3", Live: "b2xk"},
+		{Path: "data.key", Expected: "dmFsdWU=", Absent: true},
+	}
+	redactFields(fields)
+	want := []Field{
+		{Path: "data.token", Expected: redact.Placeholder, Live: redact.Placeholder},
+		{Path: "data.key", Expected: redact.Placeholder, Absent: true},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("redactFields() = %#v, want %#v", fields, want)
+	}
+}
@@ -0,0 +1,177 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/deployer"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"helm.sh/helm/v3/pkg/chart"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/dynamic"
+)
+
+// Resource represents the drift of a single release resource.
+type Resource struct {
+	Kind      string  // resource kind
+	Namespace string  // resource namespace, empty for cluster scoped
+	Name      string  // resource name
+	Missing   bool    // resource is not found on the cluster
+	Fields    []Field // field-level differences
+}
+
+// Release represents the drift of a Helm release, only the drifted resources
+// are listed.
+type Release struct {
+	Name      string     // release name
+	Namespace string     // release namespace
+	Resources []Resource // drifted resources
+}
+
+// Detector compares the Helm releases resources with the live cluster state.
+type Detector struct {
+	logger *slog.Logger  // application logger
+	flags  *api.Flags    // global flags, the installation instance
+	kube   k8s.Interface // kubernetes client
+}
+
+// ErrDriftDetected the live cluster state differs from the releases.
+var ErrDriftDetected = errors.New("drift detected")
+
+// Drifted asserts whether the release has drifted resources.
+func (r *Release) Drifted() bool {
+	return len(r.Resources) > 0
+}
+
+// Print writes the release drift report, field-level differences shown with the
+// release manifest value first, followed by the live value.
+func (r *Release) Print(w io.Writer) {
+	if !r.Drifted() {
+		fmt.Fprintf(w, "# Release %q (namespace %q): no drift.\n",
+			r.Name, r.Namespace)
+		return
+	}
+	fmt.Fprintf(w, "# Release %q (namespace %q): %d drifted resource(s).\n",
+		r.Name, r.Namespace, len(r.Resources))
+	for _, res := range r.Resources {
+		name := res.Name
+		if res.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", res.Namespace, res.Name)
+		}
+		if res.Missing {
+			fmt.Fprintf(w, "%s %s: missing on the cluster\n", res.Kind, name)
+			continue
+		}
+		fmt.Fprintf(w, "%s %s:\n", res.Kind, name)
+		for _, f := range res.Fields {
+			if f.Absent {
+				fmt.Fprintf(w, "  - %s: %v (absent on the cluster)\n",
+					f.Path, f.Expected)
+				continue
+			}
+			fmt.Fprintf(w, "  ~ %s: %v -> %v\n", f.Path, f.Expected, f.Live)
+		}
+	}
+}
+
+// toMap converts the object to its unstructured representation.
+func toMap(obj runtime.Object) (map[string]any, error) {
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
+
+// inspectResource compares a single release resource with the live object.
+func (d *Detector) inspectResource(
+	ctx context.Context,
+	client dynamic.Interface,
+	info *resource.Info,
+) (*Resource, error) {
+	kind := info.Mapping.GroupVersionKind.Kind
+	res := &Resource{Kind: kind, Name: info.Name}
+
+	var ri dynamic.ResourceInterface = client.Resource(info.Mapping.Resource)
+	if info.Mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		res.Namespace = info.Namespace
+		ri = client.Resource(info.Mapping.Resource).Namespace(info.Namespace)
+	}
+	d.logger.Debug("Inspecting resource", "kind", kind,
+		"namespace", res.Namespace, "name", res.Name)
+
+	live, err := ri.Get(ctx, info.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			res.Missing = true
+			return res, nil
+		}
+		return nil, err
+	}
+	expected, err := toMap(info.Object)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "Secret" && info.Mapping.GroupVersionKind.Group == "" {
+		normalizeSecret(expected)
+		res.Fields = compareObjects(expected, live.UnstructuredContent())
+		redactFields(res.Fields)
+	} else {
+		res.Fields = compareObjects(expected, live.UnstructuredContent())
+	}
+	return res, nil
+}
+
+// Inspect compares the resources of the chart Helm release with the live
+// objects on the cluster, returns "driver.ErrReleaseNotFound" when the release
+// is not deployed.
+func (d *Detector) Inspect(
+	ctx context.Context,
+	namespace string,
+	hc *chart.Chart,
+) (*Release, error) {
+	h, err := deployer.NewHelm(d.logger, io.Discard, d.flags, d.kube, namespace, hc)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.LoadRelease(); err != nil {
+		return nil, err
+	}
+	client, err := d.kube.DynamicClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Release{
+		Name:      d.flags.Scoped(hc.Name()),
+		Namespace: namespace,
+		Resources: []Resource{},
+	}
+	err = h.VisitReleaseResources(ctx, deployer.CollectorFunc(
+		func(ctx context.Context, info *resource.Info) error {
+			res, err := d.inspectResource(ctx, client, info)
+			if err != nil {
+				return err
+			}
+			if res.Missing || len(res.Fields) > 0 {
+				report.Resources = append(report.Resources, *res)
+			}
+			return nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// NewDetector instantiates the drift detector.
+func NewDetector(logger *slog.Logger, f *api.Flags, kube k8s.Interface) *Detector {
+	return &Detector{logger: logger, flags: f, kube: kube}
+}
@@ -0,0 +1,60 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/api"
+)
+
+const (
+	// DeveloperHubProduct product name of Red Hat Developer Hub.
+	DeveloperHubProduct = "Developer Hub"
+	// developerHubRoute the Route exposing Developer Hub, on the product
+	// namespace, named after the Backstage instance.
+	developerHubRoute = "backstage-developer-hub"
+
+	// pipelinesNamespace the OpenShift Pipelines operator namespace.
+	pipelinesNamespace = "openshift-pipelines"
+	// pipelinesAsCodeRoute the Route exposing the Pipelines-as-Code controller.
+	pipelinesAsCodeRoute = "pipelines-as-code-controller"
+)
+
+// DeveloperHubURL returns the Developer Hub base URL.
+func DeveloperHubURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	namespace, err := ic.GetProductNamespace(DeveloperHubProduct)
+	if err != nil {
+		return "", fmt.Errorf("product unavailable: %w", err)
+	}
+	host, err := HostOrDefault(ctx, ic, namespace, developerHubRoute,
+		fmt.Sprintf("%s-%s", developerHubRoute, namespace))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s", host), nil
+}
+
+// DeveloperHubCallbackURL returns the Developer Hub authentication callback URL
+// for the informed provider, e.g. "github", "gitlab" or "oidc".
+func DeveloperHubCallbackURL(
+	ctx context.Context,
+	ic api.IntegrationContext,
+	provider string,
+) (string, error) {
+	baseURL, err := DeveloperHubURL(ctx, ic)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/api/auth/%s/handler/frame", baseURL, provider), nil
+}
+
+// PipelinesAsCodeURL returns the Pipelines-as-Code controller URL, the webhook
+// endpoint for the Git providers.
+func PipelinesAsCodeURL(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	host, err := HostOrDefault(ctx, ic, pipelinesNamespace, pipelinesAsCodeRoute,
+		fmt.Sprintf("%s-%s", pipelinesAsCodeRoute, pipelinesNamespace))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s", host), nil
+}
@@ -0,0 +1,149 @@
+package endpoints
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"github.com/redhat-appstudio/helmet/framework"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// route the OpenShift Route exposing the service with the host.
+func route(namespace, name, host string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "route.openshift.io/v1",
+		"kind":       "Route",
+		"metadata":   map[string]any{"namespace": namespace, "name": name},
+		"spec":       map[string]any{"host": host},
+	}}
+}
+
+// newIntegrationContext the framework IntegrationContext on a fake cluster with
+// the informed objects, the configuration declares the products.
+func newIntegrationContext(
+	t *testing.T,
+	payload string,
+	objects ...runtime.Object,
+) api.IntegrationContext {
+	t.Helper()
+	cfg, err := config.NewConfigFromBytes([]byte(payload), "tssc", "tssc")
+	if err != nil {
+		t.Fatalf("parsing the configuration: %v", err)
+	}
+	runCtx := &api.RunContext{
+		Kube:   k8s.NewFakeKube(objects...),
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Out:    io.Discard,
+	}
+	return framework.NewIntegrationContext(runCtx, cfg)
+}
+
+const (
+	// withDomain the configuration with the ingress domain informed.
+	withDomain = `
+tssc:
+  settings:
+    ingressDomain: apps.example.com
+  products:
+    - name: Developer Hub
+      enabled: true
+      namespace: tssc-dh
+`
+	// withoutDomain the configuration without the ingress domain.
+	withoutDomain = `
+tssc:
+  settings: {}
+  products:
+    - name: Developer Hub
+      enabled: true
+      namespace: tssc-dh
+`
+	// withoutDeveloperHub the configuration without Developer Hub.
+	withoutDeveloperHub = `
+tssc:
+  settings:
+    ingressDomain: apps.example.com
+  products: []
+`
+)
+
+func TestAuthProviderURLs(t *testing.T) {
+	tests := []struct {
+		name    string           // test case name
+		payload string           // installer configuration
+		objects []runtime.Object // cluster objects
+		want    URLs             // URLs expected
+		wantErr string           // error expected, if any
+	}{{
+		name:    "deployed",
+		payload: withoutDomain,
+		objects: []runtime.Object{
+			route("tssc-dh", developerHubRoute, "rhdh.apps.example.com"),
+			route(pipelinesNamespace, pipelinesAsCodeRoute, "pac.apps.example.com"),
+		},
+		want: URLs{
+			Callback: "https://rhdh.apps.example.com/api/auth/github/handler/frame",
+			Homepage: "https://rhdh.apps.example.com",
+			Webhook:  "https://pac.apps.example.com",
+		},
+	}, {
+		name:    "not deployed",
+		payload: withDomain,
+		want: URLs{
+			Callback: "https://backstage-developer-hub-tssc-dh.apps.example.com" +
+				"/api/auth/github/handler/frame",
+			Homepage: "https://backstage-developer-hub-tssc-dh.apps.example.com",
+			Webhook: "https://pipelines-as-code-controller-openshift-pipelines" +
+				".apps.example.com",
+		},
+	}, {
+		name:    "not deployed without ingress domain",
+		payload: withoutDomain,
+		wantErr: "ingress domain not found",
+	}, {
+		name:    "without developer hub",
+		payload: withoutDeveloperHub,
+		wantErr: "product unavailable",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := newIntegrationContext(t, tt.payload, tt.objects...)
+			got, err := AuthProviderURLs("github")(context.TODO(), ic)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AuthProviderURLs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuthProviderURLs() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("AuthProviderURLs() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookURLs(t *testing.T) {
+	ic := newIntegrationContext(t, withDomain,
+		route(pipelinesNamespace, pipelinesAsCodeRoute, "pac.apps.example.com"))
+	got, err := WebhookURLs(context.TODO(), ic)
+	if err != nil {
+		t.Fatalf("WebhookURLs() error = %v", err)
+	}
+	want := URLs{
+		Homepage: "https://backstage-developer-hub-tssc-dh.apps.example.com",
+		Webhook:  "https://pac.apps.example.com",
+	}
+	if *got != want {
+		t.Errorf("WebhookURLs() = %+v, want %+v", *got, want)
+	}
+}
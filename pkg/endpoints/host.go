@@ -0,0 +1,41 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/integrations"
+)
+
+// IngressDomain returns the cluster ingress domain.
+func IngressDomain(ctx context.Context, ic api.IntegrationContext) (string, error) {
+	cl, err := ic.GetCluster(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cl.IngressDomain()
+}
+
+// HostOrDefault returns the actual host exposing the named service, when not
+// yet deployed, the host is derived from the cluster ingress domain with the
+// informed prefix: "<prefix>.<domain>".
+func HostOrDefault(
+	ctx context.Context,
+	ic api.IntegrationContext,
+	namespace, name, prefix string,
+) (string, error) {
+	host, err := ic.GetHost(ctx, namespace, name)
+	if err == nil {
+		return host, nil
+	}
+	if !errors.Is(err, integrations.ErrHostNotFound) {
+		return "", err
+	}
+	domain, err := IngressDomain(ctx, ic)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s", prefix, domain), nil
+}
@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"context"
+
+	"github.com/redhat-appstudio/helmet/api"
+)
+
+// URLs the platform endpoints an integration is configured with.
+type URLs struct {
+	Callback string // developer hub authentication callback, if any
+	Homepage string // developer hub homepage
+	Webhook  string // pipelines-as-code webhook endpoint
+}
+
+// URLsFn resolves the URLs an integration module needs.
+type URLsFn func(ctx context.Context, ic api.IntegrationContext) (*URLs, error)
+
+// AuthProviderURLs resolves the URLs for Git providers Developer Hub
+// authenticates with, the provider name is part of the callback URL.
+func AuthProviderURLs(provider string) URLsFn {
+	return func(ctx context.Context, ic api.IntegrationContext) (*URLs, error) {
+		urls, err := WebhookURLs(ctx, ic)
+		if err != nil {
+			return nil, err
+		}
+		if urls.Callback, err = DeveloperHubCallbackURL(ctx, ic, provider); err != nil {
+			return nil, err
+		}
+		return urls, nil
+	}
+}
+
+// WebhookURLs resolves the URLs for Git providers that only reach the platform
+// through Pipelines-as-Code webhooks.
+func WebhookURLs(ctx context.Context, ic api.IntegrationContext) (*URLs, error) {
+	var err error
+	urls := &URLs{}
+	if urls.Homepage, err = DeveloperHubURL(ctx, ic); err != nil {
+		return nil, err
+	}
+	if urls.Webhook, err = PipelinesAsCodeURL(ctx, ic); err != nil {
+		return nil, err
+	}
+	return urls, nil
+}
@@ -0,0 +1,245 @@
+package githubapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v80/github"
+	appmanifest "github.com/redhat-appstudio/helmet/api/githubapp"
+)
+
+// DefaultGitHubURL the public GitHub URL.
+const DefaultGitHubURL = "https://github.com"
+
+// ErrRequirementsNotMet the GitHub App lacks permissions or events.
+var ErrRequirementsNotMet = errors.New("github app doesn't meet the requirements")
+
+// productPresets the preset each product needs from the GitHub App, by product
+// name.
+var productPresets = map[string]string{
+	"OpenShift Pipelines": appmanifest.PresetPACOnly,
+	"Developer Hub":       appmanifest.PresetRHDHOnly,
+}
+
+// App the GitHub App attributes, as informed by the API. The client ID isn't
+// part of the upstream type.
+type App struct {
+	github.App
+
+	ClientID *string `json:"client_id,omitempty"`
+}
+
+// GetClientID returns the client ID, or empty.
+func (a *App) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
+	}
+	return *a.ClientID
+}
+
+// Credentials the GitHub App credentials informed by the user, the API doesn't
+// disclose secrets.
+type Credentials struct {
+	AppID         int64  // application numeric identifier
+	PrivateKey    []byte // private key, PEM encoded
+	ClientID      string // oauth client ID, looked up when empty
+	ClientSecret  string // oauth client secret
+	WebhookSecret string // webhook secret
+}
+
+// Importer imports an existing GitHub App, authenticating as the application
+// itself to inspect its settings.
+type Importer struct {
+	logger    *slog.Logger // application logger
+	gitHubURL string       // github url, public or enterprise
+}
+
+// permissionsMap returns the permissions granted, by name.
+func permissionsMap(p *github.InstallationPermissions) (map[string]string, error) {
+	granted := map[string]string{}
+	if p == nil {
+		return granted, nil
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return granted, json.Unmarshal(payload, &granted)
+}
+
+// ProductRequirements returns what the enabled products need from the GitHub
+// App, the predicate informs whether the named product is enabled.
+func ProductRequirements(enabled func(name string) bool) appmanifest.Requirements {
+	req := appmanifest.Requirements{}
+	for _, name := range slices.Sorted(maps.Keys(productPresets)) {
+		if enabled(name) {
+			preset, _ := appmanifest.Preset(productPresets[name])
+			req = req.Merge(preset)
+		}
+	}
+	return req
+}
+
+// Check asserts the GitHub App grants the required permissions, at least on the
+// required access level, and subscribes to the required events.
+func Check(req *appmanifest.Requirements, app *App) error {
+	granted, err := permissionsMap(app.Permissions)
+	if err != nil {
+		return err
+	}
+	if missing := req.Missing(granted, app.Events); len(missing) > 0 {
+		return fmt.Errorf("%w: %s",
+			ErrRequirementsNotMet, strings.Join(missing, "; "))
+	}
+	return nil
+}
+
+// getApp retrieves the GitHub App the client is authenticated as.
+func getApp(ctx context.Context, client *github.Client, appID int64) (*App, error) {
+	req, err := client.NewRequest(http.MethodGet, "app", nil)
+	if err != nil {
+		return nil, err
+	}
+	app := &App{}
+	if _, err = client.Do(ctx, req, app); err != nil {
+		return nil, fmt.Errorf("retrieving the github app %d: %w", appID, err)
+	}
+	if app.GetID() != appID {
+		return nil, fmt.Errorf("github app ID mismatch: %d informed, %d found",
+			appID, app.GetID())
+	}
+	return app, nil
+}
+
+// Get retrieves the GitHub App authenticated with the credentials.
+func (i *Importer) Get(ctx context.Context, creds *Credentials) (*App, error) {
+	client, err := NewAppClient(i.gitHubURL, creds)
+	if err != nil {
+		return nil, err
+	}
+	return getApp(ctx, client, creds.AppID)
+}
+
+// Import retrieves the GitHub App, asserts it meets the requirements and returns
+// the integration secret data, the same shape the framework stores when it
+// creates the application.
+func (i *Importer) Import(
+	ctx context.Context,
+	creds *Credentials,
+	req *appmanifest.Requirements,
+	token string,
+) (map[string][]byte, error) {
+	logger := i.logger.With("app-id", creds.AppID, "github-url", i.gitHubURL)
+	logger.Info("Retrieving the GitHub App using its private key")
+	app, err := i.Get(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With("slug", app.GetSlug(), "owner", app.GetOwner().GetLogin())
+
+	logger.Info("Checking the GitHub App permissions and events")
+	if err = Check(req, app); err != nil {
+		return nil, err
+	}
+
+	clientID := creds.ClientID
+	switch {
+	case clientID == "":
+		clientID = app.GetClientID()
+	case app.GetClientID() != "" && app.GetClientID() != clientID:
+		return nil, fmt.Errorf("github app client ID mismatch: %q informed, %q found",
+			clientID, app.GetClientID())
+	}
+	if clientID == "" {
+		return nil, errors.New("github app client ID is not informed")
+	}
+
+	return SecretData(&github.AppConfig{
+		ID:            app.ID,
+		Slug:          app.Slug,
+		NodeID:        app.NodeID,
+		Owner:         app.Owner,
+		Name:          app.Name,
+		Description:   app.Description,
+		ExternalURL:   app.ExternalURL,
+		HTMLURL:       app.HTMLURL,
+		CreatedAt:     app.CreatedAt,
+		UpdatedAt:     app.UpdatedAt,
+		ClientID:      github.Ptr(clientID),
+		ClientSecret:  github.Ptr(creds.ClientSecret),
+		WebhookSecret: github.Ptr(creds.WebhookSecret),
+		PEM:           github.Ptr(string(creds.PrivateKey)),
+	}, token)
+}
+
+// SecretData generates the integration secret data for the GitHub App, the
+// same shape the framework stores when it creates the application.
+func SecretData(cfg *github.AppConfig, token string) (map[string][]byte, error) {
+	u, err := url.Parse(cfg.GetHTMLURL())
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		"clientId":      []byte(cfg.GetClientID()),
+		"clientSecret":  []byte(cfg.GetClientSecret()),
+		"createdAt":     []byte(cfg.GetCreatedAt().String()),
+		"externalURL":   []byte(cfg.GetExternalURL()),
+		"htmlURL":       []byte(cfg.GetHTMLURL()),
+		"host":          []byte(u.Hostname()),
+		"id":            []byte(github.Stringify(cfg.GetID())),
+		"name":          []byte(cfg.GetName()),
+		"nodeId":        []byte(cfg.GetNodeID()),
+		"ownerLogin":    []byte(cfg.GetOwner().GetLogin()),
+		"ownerId":       []byte(github.Stringify(cfg.GetOwner().GetID())),
+		"pem":           []byte(cfg.GetPEM()),
+		"slug":          []byte(cfg.GetSlug()),
+		"updatedAt":     []byte(cfg.GetUpdatedAt().String()),
+		"webhookSecret": []byte(cfg.GetWebhookSecret()),
+		"token":         []byte(token),
+	}, nil
+}
+
+// NewAppClient instantiates the GitHub API client authenticated as the
+// application, with a JWT signed by its private key.
+func NewAppClient(gitHubURL string, creds *Credentials) (*github.Client, error) {
+	key, err := ParsePrivateKey(creds.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	token, err := SignJWT(creds.AppID, key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(gitHubURL, token)
+}
+
+// NewClient instantiates the GitHub API client for public GitHub, or for the
+// enterprise instance URL. The token is optional.
+func NewClient(gitHubURL, token string) (*github.Client, error) {
+	client := github.NewClient(nil)
+	if token != "" {
+		client = client.WithAuthToken(token)
+	}
+	if gitHubURL == "" || gitHubURL == DefaultGitHubURL {
+		return client, nil
+	}
+	return client.WithEnterpriseURLs(gitHubURL, gitHubURL)
+}
+
+// NewImporter instantiates the GitHub App importer for the GitHub URL, public
+// GitHub or an enterprise instance.
+func NewImporter(logger *slog.Logger, gitHubURL string) *Importer {
+	if gitHubURL == "" {
+		gitHubURL = DefaultGitHubURL
+	}
+	return &Importer{logger: logger, gitHubURL: gitHubURL}
+}
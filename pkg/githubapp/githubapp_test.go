@@ -0,0 +1,312 @@
+package githubapp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	appmanifest "github.com/redhat-appstudio/helmet/api/githubapp"
+)
+
+// testAppID the GitHub App ID served by the test GitHub API.
+const testAppID = 42
+
+// fakeGitHub the GitHub API endpoints authenticated as the application, the
+// requests are only accepted with a JWT signed by the test key.
+type fakeGitHub struct {
+	mu sync.Mutex
+
+	app           map[string]any // the app, "GET /app"
+	installations []int64        // installations, one per page
+	deleted       []int64        // installations deleted
+	hookSecret    string         // webhook secret configured
+}
+
+// authorized asserts the request bears a JWT signed by the test key.
+func authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	return rsa.VerifyPKCS1v15(
+		&testKey.PublicKey, crypto.SHA256, digest[:], signature) == nil
+}
+
+// ServeHTTP serves the GitHub API, on the enterprise "/api/v3" prefix.
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, `{"message":"Bad credentials"}`)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+	switch {
+	case r.Method == http.MethodGet && path == "/app":
+		_ = json.NewEncoder(w).Encode(f.app)
+	case r.Method == http.MethodGet && path == "/app/installations":
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 1 {
+			page = 1
+		}
+		if page < len(f.installations) {
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s?page=%d>; rel="next"`, r.URL.Path, page+1))
+		}
+		list := []map[string]any{}
+		if page <= len(f.installations) {
+			list = append(list, map[string]any{"id": f.installations[page-1]})
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case r.Method == http.MethodDelete &&
+		strings.HasPrefix(path, "/app/installations/"):
+		id, _ := strconv.ParseInt(
+			strings.TrimPrefix(path, "/app/installations/"), 10, 64)
+		f.deleted = append(f.deleted, id)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPatch && path == "/app/hook/config":
+		hook := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&hook)
+		f.hookSecret, _ = hook["secret"].(string)
+		_ = json.NewEncoder(w).Encode(map[string]any{"content_type": "json"})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// newFakeGitHub serves the app granting the permissions and events.
+func newFakeGitHub(
+	t *testing.T,
+	permissions map[string]string,
+	events []string,
+) (*fakeGitHub, string) {
+	t.Helper()
+	f := &fakeGitHub{app: map[string]any{
+		"id":        testAppID,
+		"slug":      "tssc-app",
+		"node_id":   "A_kwDO",
+		"name":      "TSSC App",
+		"client_id": "Iv1.client",
+		"html_url":  "https://github.example.com/apps/tssc-app",
+		"owner": map[string]any{
+			"login": "tssc-org", "id": 7, "type": "Organization",
+		},
+		"permissions": permissions,
+		"events":      events,
+	}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return f, server.URL
+}
+
+// testLogger discards the log records.
+func testLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestImporter_Import(t *testing.T) {
+	pac, _ := appmanifest.Preset(appmanifest.PresetPACOnly)
+	rhdh, _ := appmanifest.Preset(appmanifest.PresetRHDHOnly)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string                   // test case name
+		creds   Credentials              // credentials informed
+		req     appmanifest.Requirements // requirements
+		wantErr error                    // error expected, if any
+		check   func(map[string][]byte) error
+	}{{
+		name:  "imported",
+		creds: Credentials{AppID: testAppID, ClientSecret: "s3cr3t"},
+		req:   pac,
+		check: func(data map[string][]byte) error {
+			want := map[string]string{
+				"id":           "42",
+				"slug":         "tssc-app",
+				"clientId":     "Iv1.client",
+				"clientSecret": "s3cr3t",
+				"host":         "github.example.com",
+				"ownerLogin":   "tssc-org",
+				"token":        "ghp_token",
+				"pem":          string(pkcs1PEM()),
+			}
+			for k, v := range want {
+				if string(data[k]) != v {
+					return fmt.Errorf("%q = %q, want %q", k, data[k], v)
+				}
+			}
+			return nil
+		},
+	}, {
+		name:  "client ID informed",
+		creds: Credentials{AppID: testAppID, ClientID: "Iv1.client"},
+		req:   pac,
+	}, {
+		name:    "client ID mismatch",
+		creds:   Credentials{AppID: testAppID, ClientID: "Iv1.other"},
+		req:     pac,
+		wantErr: errors.New("github app client ID mismatch"),
+	}, {
+		name:    "requirements not met",
+		creds:   Credentials{AppID: testAppID},
+		req:     rhdh,
+		wantErr: ErrRequirementsNotMet,
+	}, {
+		name:    "app ID mismatch",
+		creds:   Credentials{AppID: 43},
+		req:     pac,
+		wantErr: errors.New("github app ID mismatch"),
+	}, {
+		name: "other private key",
+		creds: Credentials{
+			AppID: testAppID,
+			PrivateKey: encodePEM("RSA PRIVATE KEY",
+				x509.MarshalPKCS1PrivateKey(otherKey)),
+		},
+		req:     pac,
+		wantErr: errors.New("401 Bad credentials"),
+	}, {
+		name:    "invalid private key",
+		creds:   Credentials{AppID: testAppID, PrivateKey: []byte("invalid")},
+		req:     pac,
+		wantErr: ErrInvalidPrivateKey,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, url := newFakeGitHub(t, pac.Permissions, pac.Events)
+			if tt.creds.PrivateKey == nil {
+				tt.creds.PrivateKey = pkcs1PEM()
+			}
+			data, err := NewImporter(testLogger(), url).
+				Import(t.Context(), &tt.creds, &tt.req, "ghp_token")
+			if tt.wantErr != nil {
+				if err == nil || (!errors.Is(err, tt.wantErr) &&
+					!strings.Contains(err.Error(), tt.wantErr.Error())) {
+					t.Fatalf("Import() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			if tt.check != nil {
+				if err = tt.check(data); err != nil {
+					t.Errorf("Import() data: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	pac, _ := appmanifest.Preset(appmanifest.PresetPACOnly)
+	full, _ := appmanifest.Preset(appmanifest.PresetFull)
+
+	tests := []struct {
+		name    string                   // test case name
+		req     appmanifest.Requirements // requirements
+		app     string                   // app payload, as served by the API
+		wantErr bool                     // error expected
+	}{{
+		name: "met",
+		req:  pac,
+		app: `{"permissions":{"checks":"write","contents":"write","issues":"write",
+"members":"read","metadata":"read","organization_plan":"read",
+"pull_requests":"write"},"events":["check_run","check_suite","commit_comment",
+"issue_comment","pull_request","push"]}`,
+	}, {
+		name: "admin access meets write",
+		req: appmanifest.Requirements{
+			Permissions: map[string]string{"contents": "write"},
+		},
+		app: `{"permissions":{"contents":"admin"}}`,
+	}, {
+		name:    "missing event",
+		req:     appmanifest.Requirements{Events: []string{"push"}},
+		app:     `{"events":["pull_request"]}`,
+		wantErr: true,
+	}, {
+		name:    "nothing granted",
+		req:     full,
+		app:     `{}`,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{}
+			if err := json.Unmarshal([]byte(tt.app), app); err != nil {
+				t.Fatal(err)
+			}
+			err := Check(&tt.req, app)
+			if tt.wantErr != errors.Is(err, ErrRequirementsNotMet) {
+				t.Errorf("Check() error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Check() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestProductRequirements(t *testing.T) {
+	full, _ := appmanifest.Preset(appmanifest.PresetFull)
+	rhdh, _ := appmanifest.Preset(appmanifest.PresetRHDHOnly)
+
+	tests := []struct {
+		name    string          // test case name
+		enabled map[string]bool // products enabled
+		want    appmanifest.Requirements
+	}{{
+		name:    "all products",
+		enabled: map[string]bool{"OpenShift Pipelines": true, "Developer Hub": true},
+		want:    full,
+	}, {
+		name:    "developer hub",
+		enabled: map[string]bool{"Developer Hub": true},
+		want:    rhdh,
+	}, {
+		name: "none",
+		want: appmanifest.Requirements{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProductRequirements(func(name string) bool {
+				return tt.enabled[name]
+			})
+			if missing := tt.want.Missing(got.Permissions, got.Events); len(missing) > 0 {
+				t.Errorf("ProductRequirements() lacks %v", missing)
+			}
+			if missing := got.Missing(tt.want.Permissions, tt.want.Events); len(missing) > 0 {
+				t.Errorf("ProductRequirements() exceeds with %v", missing)
+			}
+		})
+	}
+}
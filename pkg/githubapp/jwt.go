@@ -0,0 +1,71 @@
+package githubapp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidPrivateKey the PEM payload doesn't contain a RSA private key.
+var ErrInvalidPrivateKey = errors.New("invalid github app private key")
+
+// jwtLifetime the JWT expiration, GitHub refuses tokens valid for more than ten
+// minutes. The issued-at is set in the past to tolerate clock drift.
+const (
+	jwtLifetime = 9 * time.Minute
+	jwtDrift    = 60 * time.Second
+)
+
+// ParsePrivateKey parses the GitHub App private key, GitHub issues PKCS#1 keys
+// although PKCS#8 is accepted as well.
+func ParsePrivateKey(payload []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(payload)
+	if block == nil {
+		return nil, fmt.Errorf("%w: PEM block not found", ErrInvalidPrivateKey)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not a RSA key", ErrInvalidPrivateKey)
+	}
+	return rsaKey, nil
+}
+
+// SignJWT issues the RS256 JSON Web Token authenticating as the GitHub App.
+func SignJWT(appID int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iat": now.Add(-jwtDrift).Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(signature), nil
+}
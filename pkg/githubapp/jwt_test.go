@@ -0,0 +1,139 @@
+package githubapp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testKey the RSA key shared by the tests, generating keys is slow.
+var testKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// encodePEM encodes the DER payload as PEM, with the block type.
+func encodePEM(kind string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der})
+}
+
+// pkcs1PEM the test key PEM encoded as GitHub issues it.
+func pkcs1PEM() []byte {
+	return encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(testKey))
+}
+
+// verifyJWT asserts the token is signed by the test key, returning the claims.
+func verifyJWT(t *testing.T, token string) map[string]any {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT has %d parts, want 3", len(parts))
+	}
+	enc := base64.RawURLEncoding
+	signature, err := enc.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("decoding the signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(
+		&testKey.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("verifying the signature: %v", err)
+	}
+
+	header := map[string]string{}
+	payload, _ := enc.DecodeString(parts[0])
+	if err = json.Unmarshal(payload, &header); err != nil {
+		t.Fatalf("decoding the header: %v", err)
+	}
+	if header["alg"] != "RS256" || header["typ"] != "JWT" {
+		t.Errorf("JWT header = %v, want RS256 JWT", header)
+	}
+	claims := map[string]any{}
+	payload, _ = enc.DecodeString(parts[1])
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		t.Fatalf("decoding the claims: %v", err)
+	}
+	return claims
+}
+
+func TestSignJWT(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	token, err := SignJWT(42, testKey, now)
+	if err != nil {
+		t.Fatalf("SignJWT() error = %v", err)
+	}
+	claims := verifyJWT(t, token)
+	want := map[string]any{
+		"iss": "42",
+		"iat": float64(now.Add(-jwtDrift).Unix()),
+		"exp": float64(now.Add(jwtLifetime).Unix()),
+	}
+	for k, v := range want {
+		if claims[k] != v {
+			t.Errorf("claim %q = %v, want %v", k, claims[k], v)
+		}
+	}
+	// GitHub refuses tokens valid for more than ten minutes.
+	if lifetime := claims["exp"].(float64) - claims["iat"].(float64); lifetime > 600 {
+		t.Errorf("JWT valid for %vs, want at most 600s", lifetime)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKCS8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string // test case name
+		payload []byte // PEM payload
+		wantErr bool   // error expected
+	}{
+		{name: "pkcs1", payload: pkcs1PEM()},
+		{name: "pkcs8", payload: encodePEM("PRIVATE KEY", pkcs8)},
+		{name: "not pem", payload: []byte("not a key"), wantErr: true},
+		{name: "invalid der", payload: encodePEM("PRIVATE KEY", []byte("x")), wantErr: true},
+		{name: "not rsa", payload: encodePEM("PRIVATE KEY", ecPKCS8), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKey(tt.payload)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPrivateKey) {
+					t.Fatalf("ParsePrivateKey() error = %v, want %v",
+						err, ErrInvalidPrivateKey)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePrivateKey() error = %v", err)
+			}
+			if !key.Equal(testKey) {
+				t.Error("ParsePrivateKey() returned a different key")
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package githubapp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/google/go-github/v80/github"
+)
+
+// Manager manages the lifecycle of an existing GitHub App, authenticated as the
+// application itself. GitHub's REST API doesn't delete app registrations nor
+// issue client secrets, both only happen on the web interface, thus the app is
+// uninstalled and the settings URL is informed instead.
+type Manager struct {
+	logger *slog.Logger   // application logger
+	client *github.Client // github api client, authenticated as the app
+	appID  int64          // github app ID
+}
+
+// ErrIncompleteSecret the integration secret lacks the GitHub App credentials.
+var ErrIncompleteSecret = errors.New("github app integration secret incomplete")
+
+// webhookSecretBytes random bytes of a generated webhook secret.
+const webhookSecretBytes = 32
+
+// CredentialsFromSecret reads the GitHub App credentials stored on the
+// integration secret data.
+func CredentialsFromSecret(data map[string][]byte) (*Credentials, error) {
+	if len(data["id"]) == 0 || len(data["pem"]) == 0 {
+		return nil, fmt.Errorf("%w: %q and %q are required",
+			ErrIncompleteSecret, "id", "pem")
+	}
+	appID, err := strconv.ParseInt(string(data["id"]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid app ID: %w", ErrIncompleteSecret, err)
+	}
+	return &Credentials{
+		AppID:         appID,
+		PrivateKey:    data["pem"],
+		ClientID:      string(data["clientId"]),
+		ClientSecret:  string(data["clientSecret"]),
+		WebhookSecret: string(data["webhookSecret"]),
+	}, nil
+}
+
+// GitHubURL returns the GitHub URL for the host stored on the integration
+// secret, public GitHub or the enterprise instance.
+func GitHubURL(host string) string {
+	if host == "" || host == "github.com" {
+		return DefaultGitHubURL
+	}
+	return "https://" + host
+}
+
+// SettingsURL returns the GitHub App settings page, where the app is deleted and
+// the client secrets are generated.
+func SettingsURL(gitHubURL string, app *App) string {
+	if app.GetOwner().GetType() == "Organization" {
+		return fmt.Sprintf("%s/organizations/%s/settings/apps/%s",
+			gitHubURL, app.GetOwner().GetLogin(), app.GetSlug())
+	}
+	return fmt.Sprintf("%s/settings/apps/%s", gitHubURL, app.GetSlug())
+}
+
+// App retrieves the GitHub App.
+func (m *Manager) App(ctx context.Context) (*App, error) {
+	return getApp(ctx, m.client, m.appID)
+}
+
+// Uninstall removes every installation of the GitHub App, revoking its access to
+// the organizations and repositories. It returns the number of installations
+// removed.
+func (m *Manager) Uninstall(ctx context.Context) (int, error) {
+	var ids []int64
+	opts := &github.ListOptions{PerPage: 100, Page: 1}
+	for {
+		installations, resp, err := m.client.Apps.ListInstallations(ctx, opts)
+		if err != nil {
+			return 0, fmt.Errorf("listing the github app installations: %w", err)
+		}
+		for _, i := range installations {
+			ids = append(ids, i.GetID())
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	for _, id := range ids {
+		m.logger.Info("Removing the GitHub App installation", "installation-id", id)
+		if _, err := m.client.Apps.DeleteInstallation(ctx, id); err != nil {
+			return 0, fmt.Errorf("removing the github app installation %d: %w",
+				id, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// RotateWebhookSecret generates a new webhook secret and configures it on the
+// GitHub App, returning the new secret. Deliveries signed with the previous
+// secret are rejected by the receivers still using it.
+func (m *Manager) RotateWebhookSecret(ctx context.Context) (string, error) {
+	buf := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	secret := hex.EncodeToString(buf)
+	m.logger.Info("Configuring the new GitHub App webhook secret")
+	_, _, err := m.client.Apps.UpdateHookConfig(ctx, &github.HookConfig{
+		Secret: github.Ptr(secret),
+	})
+	if err != nil {
+		return "", fmt.Errorf("updating the github app webhook secret: %w", err)
+	}
+	return secret, nil
+}
+
+// NewManager instantiates the GitHub App manager for the credentials, on public
+// GitHub or the enterprise instance URL.
+func NewManager(
+	logger *slog.Logger,
+	gitHubURL string,
+	creds *Credentials,
+) (*Manager, error) {
+	client, err := NewAppClient(gitHubURL, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		logger: logger.With("app-id", creds.AppID),
+		client: client,
+		appID:  creds.AppID,
+	}, nil
+}
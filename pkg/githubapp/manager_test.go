@@ -0,0 +1,131 @@
+package githubapp
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// newTestManager the manager authenticated as the app served by the fake API.
+func newTestManager(t *testing.T) (*Manager, *fakeGitHub) {
+	t.Helper()
+	f, url := newFakeGitHub(t, nil, nil)
+	m, err := NewManager(testLogger(), url,
+		&Credentials{AppID: testAppID, PrivateKey: pkcs1PEM()})
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	return m, f
+}
+
+func TestManager_App(t *testing.T) {
+	m, _ := newTestManager(t)
+	app, err := m.App(t.Context())
+	if err != nil {
+		t.Fatalf("App() error = %v", err)
+	}
+	if app.GetSlug() != "tssc-app" || app.GetClientID() != "Iv1.client" {
+		t.Errorf("App() = %q %q, want tssc-app Iv1.client",
+			app.GetSlug(), app.GetClientID())
+	}
+	want := "https://github.example.com/organizations/tssc-org/settings/apps/tssc-app"
+	if got := SettingsURL("https://github.example.com", app); got != want {
+		t.Errorf("SettingsURL() = %q, want %q", got, want)
+	}
+}
+
+func TestManager_Uninstall(t *testing.T) {
+	m, f := newTestManager(t)
+	f.installations = []int64{10, 11, 12}
+
+	n, err := m.Uninstall(t.Context())
+	if err != nil {
+		t.Fatalf("Uninstall() error = %v", err)
+	}
+	if n != 3 {
+		t.Errorf("Uninstall() = %d, want 3", n)
+	}
+	if !reflect.DeepEqual(f.deleted, f.installations) {
+		t.Errorf("installations deleted = %v, want %v", f.deleted, f.installations)
+	}
+}
+
+func TestManager_RotateWebhookSecret(t *testing.T) {
+	m, f := newTestManager(t)
+
+	secret, err := m.RotateWebhookSecret(t.Context())
+	if err != nil {
+		t.Fatalf("RotateWebhookSecret() error = %v", err)
+	}
+	if raw, err := hex.DecodeString(secret); err != nil || len(raw) != webhookSecretBytes {
+		t.Errorf("RotateWebhookSecret() = %q, want %d random bytes hex encoded",
+			secret, webhookSecretBytes)
+	}
+	if f.hookSecret != secret {
+		t.Errorf("webhook secret configured = %q, want %q", f.hookSecret, secret)
+	}
+}
+
+func TestCredentialsFromSecret(t *testing.T) {
+	tests := []struct {
+		name    string            // test case name
+		data    map[string][]byte // integration secret data
+		want    *Credentials      // credentials expected
+		wantErr bool              // error expected
+	}{{
+		name: "complete",
+		data: map[string][]byte{
+			"id":            []byte("42"),
+			"pem":           []byte("pem"),
+			"clientId":      []byte("Iv1.client"),
+			"clientSecret":  []byte("client-secret"),
+			"webhookSecret": []byte("webhook-secret"),
+		},
+		want: &Credentials{
+			AppID:         42,
+			PrivateKey:    []byte("pem"),
+			ClientID:      "Iv1.client",
+			ClientSecret:  "client-secret",
+			WebhookSecret: "webhook-secret",
+		},
+	}, {
+		name:    "without private key",
+		data:    map[string][]byte{"id": []byte("42")},
+		wantErr: true,
+	}, {
+		name:    "invalid app ID",
+		data:    map[string][]byte{"id": []byte("app"), "pem": []byte("pem")},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CredentialsFromSecret(tt.data)
+			if tt.wantErr {
+				if !errors.Is(err, ErrIncompleteSecret) {
+					t.Fatalf("CredentialsFromSecret() error = %v, want %v",
+						err, ErrIncompleteSecret)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CredentialsFromSecret() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CredentialsFromSecret() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubURL(t *testing.T) {
+	for host, want := range map[string]string{
+		"":                   DefaultGitHubURL,
+		"github.com":         DefaultGitHubURL,
+		"github.example.com": "https://github.example.com",
+	} {
+		if got := GitHubURL(host); got != want {
+			t.Errorf("GitHubURL(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package gitlabapp
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// OAuthApp manages the GitLab OAuth application Developer Hub authenticates
+// users with. GitLab's REST API manages applications owned by the instance, thus
+// the application is named after the group it's meant for, and the token must
+// belong to an administrator.
+type OAuthApp struct {
+	logger *slog.Logger   // application logger
+	client *gitlab.Client // gitlab api client
+}
+
+// Credentials the OAuth application credentials.
+type Credentials struct {
+	ID           int64  // application numeric identifier
+	ClientID     string // application client ID ("application_id")
+	ClientSecret string // application client secret
+}
+
+// Scopes OAuth scopes requested by Developer Hub, to sign users in and to read
+// and publish the repositories scaffolded by the software templates.
+const Scopes = "api read_user read_repository write_repository openid profile email"
+
+// ErrApplicationNotFound the OAuth application is not found.
+var ErrApplicationNotFound = errors.New("gitlab oauth application not found")
+
+// Name returns the OAuth application name for the application and group.
+func Name(appName, group string) string {
+	return fmt.Sprintf("%s-%s", appName, group)
+}
+
+// Find looks up the OAuth application by name.
+func (a *OAuthApp) Find(name string) (*gitlab.Application, error) {
+	opts := &gitlab.ListApplicationsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+	}
+	for {
+		apps, resp, err := a.client.Applications.ListApplications(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			if app.ApplicationName == name {
+				return app, nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil, fmt.Errorf("%w: %q", ErrApplicationNotFound, name)
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// renewSecret issues a new client secret for the application, the current one
+// stops working. The client ID, and thus the application, is kept.
+func (a *OAuthApp) renewSecret(id int64) (*gitlab.Application, error) {
+	req, err := a.client.NewRequest(http.MethodPost,
+		fmt.Sprintf("applications/%d/renew-secret", id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	app := &gitlab.Application{}
+	if _, err = a.client.Do(req, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// create creates a new confidential OAuth application.
+func (a *OAuthApp) create(name, callbackURL string) (*gitlab.Application, error) {
+	app, _, err := a.client.Applications.CreateApplication(
+		&gitlab.CreateApplicationOptions{
+			Name:         gitlab.Ptr(name),
+			RedirectURI:  gitlab.Ptr(callbackURL),
+			Scopes:       gitlab.Ptr(Scopes),
+			Confidential: gitlab.Ptr(true),
+		})
+	return app, err
+}
+
+// Ensure creates the OAuth application, or reuses the existing one with the
+// same name. Client secrets can't be read back from GitLab, reusing the
+// application issues a new secret, rotating the credentials. An existing
+// application with a different callback URL is recreated, the API doesn't
+// support updating it: the new application is created first, the previous is
+// only deleted afterwards, so a failure doesn't leave Developer Hub without one.
+func (a *OAuthApp) Ensure(name, callbackURL string) (*Credentials, error) {
+	logger := a.logger.With("name", name, "callback-url", callbackURL)
+	app, err := a.Find(name)
+	if err != nil && !errors.Is(err, ErrApplicationNotFound) {
+		return nil, err
+	}
+
+	switch {
+	case app != nil && app.CallbackURL == callbackURL:
+		logger.Info("Reusing the GitLab OAuth application, renewing its secret...")
+		id := app.ID
+		if app, err = a.renewSecret(id); err != nil {
+			return nil, err
+		}
+		app.ID = id
+	default:
+		previous := app
+		logger.Info("Creating the GitLab OAuth application...")
+		if app, err = a.create(name, callbackURL); err != nil {
+			return nil, err
+		}
+		if previous == nil {
+			break
+		}
+		// The new application is in place, a failure deleting the previous one
+		// is not fatal, the credentials issued would be lost otherwise.
+		logger.Info("Callback URL changed, deleting the previous GitLab OAuth application...",
+			"previous-id", previous.ID,
+			"previous-callback-url", previous.CallbackURL)
+		if _, err = a.client.Applications.DeleteApplication(previous.ID); err != nil {
+			logger.Warn("Unable to delete the previous GitLab OAuth application, "+
+				"delete it manually", "previous-id", previous.ID, "err", err)
+		}
+	}
+
+	if app.ApplicationID == "" || app.Secret == "" {
+		return nil, fmt.Errorf("gitlab oauth application %q: incomplete credentials",
+			name)
+	}
+	return &Credentials{
+		ID:           app.ID,
+		ClientID:     app.ApplicationID,
+		ClientSecret: app.Secret,
+	}, nil
+}
+
+// NewClient instantiates the GitLab API client for the base URL, for instance
+// "https://gitlab.com".
+func NewClient(baseURL, token string, insecure bool) (*gitlab.Client, error) {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: insecure, //nolint:gosec
+			MinVersion:         tls.VersionTLS12,
+		},
+	}
+	return gitlab.NewClient(
+		token,
+		gitlab.WithBaseURL(baseURL),
+		gitlab.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+}
+
+// NewOAuthApp instantiates the OAuth application manager.
+func NewOAuthApp(logger *slog.Logger, client *gitlab.Client) *OAuthApp {
+	return &OAuthApp{logger: logger, client: client}
+}
@@ -0,0 +1,235 @@
+package gitlabapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// fakeGitLab serves the GitLab applications API, recording the requests.
+type fakeGitLab struct {
+	apps     []*gitlab.Application // applications on the instance
+	requests []string              // method and path of the requests served
+	created  map[string]any        // last application creation payload
+	secret   string                // client secret issued
+	failing  string                // method and path answered with an error
+}
+
+// index returns the application position by the path ID, or -1.
+func (f *fakeGitLab) index(r *http.Request) int {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return slices.IndexFunc(f.apps, func(app *gitlab.Application) bool {
+		return app.ID == id
+	})
+}
+
+// handler serves the applications API endpoints used by OAuthApp.
+func (f *fakeGitLab) handler() http.Handler {
+	writeApp := func(w http.ResponseWriter, app *gitlab.Application) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(app)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/applications", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(f.apps)
+	})
+	mux.HandleFunc("POST /api/v4/applications", func(w http.ResponseWriter, r *http.Request) {
+		f.created = map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&f.created)
+		app := &gitlab.Application{
+			ID:              int64(len(f.apps) + 10),
+			ApplicationID:   "client-id",
+			ApplicationName: fmt.Sprint(f.created["name"]),
+			Secret:          f.secret,
+			CallbackURL:     fmt.Sprint(f.created["redirect_uri"]),
+			Confidential:    true,
+		}
+		f.apps = append(f.apps, app)
+		writeApp(w, app)
+	})
+	mux.HandleFunc("POST /api/v4/applications/{id}/renew-secret", func(w http.ResponseWriter, r *http.Request) {
+		i := f.index(r)
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		renewed := *f.apps[i]
+		renewed.Secret = "renewed-" + f.secret
+		writeApp(w, &renewed)
+	})
+	mux.HandleFunc("DELETE /api/v4/applications/{id}", func(w http.ResponseWriter, r *http.Request) {
+		i := f.index(r)
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.apps = slices.Delete(f.apps, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+		if r.Method+" "+r.URL.Path == f.failing {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestOAuthApp_Ensure(t *testing.T) {
+	const (
+		name        = "tssc-platform"
+		callbackURL = "https://rhdh.example.com/api/auth/gitlab/handler/frame"
+	)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name     string
+		apps     []*gitlab.Application
+		noSecret bool
+		failing  string
+		want     *Credentials
+		requests []string
+		wantErr  string
+		wantApps []int64 // applications left on the instance
+	}{{
+		name: "creates the application",
+		apps: []*gitlab.Application{{
+			ID: 1, ApplicationName: "other", CallbackURL: callbackURL,
+		}},
+		want: &Credentials{ID: 11, ClientID: "client-id", ClientSecret: "secret"},
+		requests: []string{
+			"GET /api/v4/applications",
+			"POST /api/v4/applications",
+		},
+	}, {
+		name: "renews the existing application secret",
+		apps: []*gitlab.Application{{
+			ID: 7, ApplicationID: "client-id", ApplicationName: name,
+			CallbackURL: callbackURL,
+		}},
+		want: &Credentials{
+			ID: 7, ClientID: "client-id", ClientSecret: "renewed-secret",
+		},
+		requests: []string{
+			"GET /api/v4/applications",
+			"POST /api/v4/applications/7/renew-secret",
+		},
+	}, {
+		name: "recreates the application on callback change",
+		apps: []*gitlab.Application{{
+			ID: 7, ApplicationID: "old-client-id", ApplicationName: name,
+			CallbackURL: "https://old.example.com/callback",
+		}},
+		want: &Credentials{ID: 11, ClientID: "client-id", ClientSecret: "secret"},
+		requests: []string{
+			"GET /api/v4/applications",
+			"POST /api/v4/applications",
+			"DELETE /api/v4/applications/7",
+		},
+		wantApps: []int64{11},
+	}, {
+		name: "keeps the previous application when creating fails",
+		apps: []*gitlab.Application{{
+			ID: 7, ApplicationID: "old-client-id", ApplicationName: name,
+			CallbackURL: "https://old.example.com/callback",
+		}},
+		failing: "POST /api/v4/applications",
+		requests: []string{
+			"GET /api/v4/applications",
+			"POST /api/v4/applications",
+		},
+		wantErr:  "403",
+		wantApps: []int64{7},
+	}, {
+		name: "new credentials when deleting the previous application fails",
+		apps: []*gitlab.Application{{
+			ID: 7, ApplicationID: "old-client-id", ApplicationName: name,
+			CallbackURL: "https://old.example.com/callback",
+		}},
+		failing: "DELETE /api/v4/applications/7",
+		want:    &Credentials{ID: 11, ClientID: "client-id", ClientSecret: "secret"},
+		requests: []string{
+			"GET /api/v4/applications",
+			"POST /api/v4/applications",
+			"DELETE /api/v4/applications/7",
+		},
+		wantApps: []int64{7, 11},
+	}, {
+		name:     "incomplete credentials",
+		noSecret: true,
+		wantErr:  "incomplete credentials",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeGitLab{
+				apps: tt.apps, secret: "secret", failing: tt.failing,
+			}
+			if tt.noSecret {
+				fake.secret = ""
+			}
+			server := httptest.NewServer(fake.handler())
+			defer server.Close()
+
+			client, err := NewClient(server.URL, "token", false)
+			if err != nil {
+				t.Fatalf("NewClient() = %v", err)
+			}
+			creds, err := NewOAuthApp(logger, client).Ensure(name, callbackURL)
+			if tt.wantApps != nil {
+				ids := []int64{}
+				for _, app := range fake.apps {
+					ids = append(ids, app.ID)
+				}
+				if !slices.Equal(ids, tt.wantApps) {
+					t.Errorf("applications = %v, want %v", ids, tt.wantApps)
+				}
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Ensure() = %v, want error %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Ensure() = %v", err)
+			}
+			if *creds != *tt.want {
+				t.Errorf("Ensure() = %+v, want %+v", creds, tt.want)
+			}
+			if !slices.Equal(fake.requests, tt.requests) {
+				t.Errorf("requests:\n got: %v\nwant: %v", fake.requests, tt.requests)
+			}
+			if fake.created == nil {
+				return
+			}
+			want := map[string]any{
+				"name":         name,
+				"scopes":       Scopes,
+				"redirect_uri": callbackURL,
+				"confidential": true,
+			}
+			for k, v := range want {
+				if !reflect.DeepEqual(fake.created[k], v) {
+					t.Errorf("created %s = %v, want %v", k, fake.created[k], v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package integrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/annotations"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AppliedHashAnnotation the hash of the parameters the integration secret was
+// last applied with, by "integration apply".
+const AppliedHashAnnotation = annotations.RepoURI + "/applied-hash"
+
+// Entry the parameters of an integration on the integrations file, the flags
+// of the "integration <name>" subcommand and its positional arguments.
+type Entry struct {
+	Name  string            // integration name
+	Args  []string          // positional arguments
+	Flags map[string]string // flag values, resolved
+}
+
+// Applied the declarative integrations file, "integration apply" input:
+//
+//	integrations:
+//	  quay:
+//	    url: https://quay.io
+//	    token: ${QUAY_TOKEN}
+//	    dockerconfigjson: "@quay-auth.json"
+//	  github:
+//	    args: [my-app]
+//	    app-id: 123456
+//
+// Values are resolved from environment variables, "${ENV}", and files, "@file"
+// relative to the integrations file; "@@" escapes a literal "@".
+type Applied struct {
+	Entries []Entry // integrations, sorted by name
+}
+
+// ErrInvalidApplied the integrations file is invalid.
+var ErrInvalidApplied = errors.New("invalid integrations file")
+
+// envPattern environment variable references.
+var envPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// resolve resolves the value references, environment variables and files.
+func resolve(value, baseDir string) (string, error) {
+	if strings.HasPrefix(value, "@@") {
+		return value[1:], nil
+	}
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		payload, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(payload), "\n"), nil
+	}
+	var missing []string
+	resolved := envPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := envPattern.FindStringSubmatch(ref)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable(s) not set: %v", missing)
+	}
+	return resolved, nil
+}
+
+// scalar returns the YAML scalar as string.
+func scalar(v any) (string, error) {
+	switch v.(type) {
+	case map[string]any, []any:
+		return "", fmt.Errorf("expected a scalar, got %T", v)
+	case nil:
+		return "", nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// LoadApplied reads the integrations file, resolving the values. The base
+// directory is where relative file references are resolved.
+func LoadApplied(r io.Reader, baseDir string) (*Applied, error) {
+	doc := struct {
+		Integrations map[string]map[string]any `yaml:"integrations"`
+	}{}
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidApplied, err)
+	}
+	if len(doc.Integrations) == 0 {
+		return nil, fmt.Errorf("%w: no integrations informed", ErrInvalidApplied)
+	}
+
+	applied := &Applied{}
+	for _, name := range slices.Sorted(maps.Keys(doc.Integrations)) {
+		entry := Entry{Name: name, Flags: map[string]string{}}
+		for key, v := range doc.Integrations[name] {
+			if key == "args" {
+				args, ok := v.([]any)
+				if !ok {
+					return nil, fmt.Errorf("%w: %s: args: expected a list",
+						ErrInvalidApplied, name)
+				}
+				for _, a := range args {
+					s, err := scalar(a)
+					if err != nil {
+						return nil, fmt.Errorf("%w: %s: args: %w",
+							ErrInvalidApplied, name, err)
+					}
+					if s, err = resolve(s, baseDir); err != nil {
+						return nil, fmt.Errorf("%w: %s: args: %w",
+							ErrInvalidApplied, name, err)
+					}
+					entry.Args = append(entry.Args, s)
+				}
+				continue
+			}
+			s, err := scalar(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s: %w",
+					ErrInvalidApplied, name, key, err)
+			}
+			if entry.Flags[key], err = resolve(s, baseDir); err != nil {
+				return nil, fmt.Errorf("%w: %s: %s: %w",
+					ErrInvalidApplied, name, key, err)
+			}
+		}
+		applied.Entries = append(applied.Entries, entry)
+	}
+	return applied, nil
+}
+
+// Argv returns the entry as command line arguments, flags sorted by name.
+func (e *Entry) Argv() []string {
+	argv := make([]string, 0, len(e.Flags)+len(e.Args))
+	for _, name := range slices.Sorted(maps.Keys(e.Flags)) {
+		argv = append(argv, fmt.Sprintf("--%s=%s", name, e.Flags[name]))
+	}
+	return append(argv, e.Args...)
+}
+
+// Hash returns the digest of the entry parameters, it tells whether the
+// integration changed since last applied.
+func (e *Entry) Hash() string {
+	payload, _ := json.Marshal(e.Argv())
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// AnnotateSecret sets the annotation on the integration secret.
+func AnnotateSecret(
+	ctx context.Context,
+	kube k8s.Interface,
+	cfg *config.Config,
+	i *api.Integration,
+	key, value string,
+) error {
+	cs, err := kube.ClientSet(cfg.Namespace())
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = cs.CoreV1().Secrets(cfg.Namespace()).Patch(ctx,
+		i.SecretName(), types.MergePatchType, patch,
+		metav1.PatchOptions{})
+	return err
+}
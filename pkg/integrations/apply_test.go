@@ -0,0 +1,209 @@
+package integrations
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	baseDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(baseDir, "token.txt"),
+		[]byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TSSC_TEST_TOKEN", "env-token")
+	t.Setenv("TSSC_TEST_HOST", "quay.example.com")
+
+	tests := []struct {
+		name    string // test case name
+		value   string // value on the integrations file
+		want    string // value resolved
+		wantErr string // error expected, if any
+	}{{
+		name:  "plain",
+		value: "https://quay.io",
+		want:  "https://quay.io",
+	}, {
+		name:  "environment variable",
+		value: "${TSSC_TEST_TOKEN}",
+		want:  "env-token",
+	}, {
+		name:  "environment variables embedded",
+		value: "https://${TSSC_TEST_HOST}/${TSSC_TEST_TOKEN}",
+		want:  "https://quay.example.com/env-token",
+	}, {
+		name:  "not a reference",
+		value: "$TSSC_TEST_TOKEN",
+		want:  "$TSSC_TEST_TOKEN",
+	}, {
+		name:    "missing environment variable",
+		value:   "${TSSC_TEST_MISSING}",
+		wantErr: "environment variable(s) not set: [TSSC_TEST_MISSING]",
+	}, {
+		name:  "relative file",
+		value: "@token.txt",
+		want:  "file-token",
+	}, {
+		name:  "absolute file",
+		value: "@" + filepath.Join(baseDir, "token.txt"),
+		want:  "file-token",
+	}, {
+		name:    "missing file",
+		value:   "@missing.txt",
+		wantErr: "missing.txt",
+	}, {
+		name:  "escaped at",
+		value: "@@team",
+		want:  "@team",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolve(tt.value, baseDir)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolve(%q) error = %v, want %q",
+						tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("resolve(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadApplied(t *testing.T) {
+	baseDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(baseDir, "auth.json"),
+		[]byte(`{"auths":{}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TSSC_TEST_TOKEN", "env-token")
+
+	tests := []struct {
+		name    string  // test case name
+		payload string  // integrations file
+		want    []Entry // entries expected
+		wantErr string  // error expected, if any
+	}{{
+		name: "resolved",
+		payload: `
+integrations:
+  quay:
+    url: https://quay.io
+    token: ${TSSC_TEST_TOKEN}
+    dockerconfigjson: "@auth.json"
+  github:
+    args: [my-app]
+    app-id: 123456
+    public: false
+`,
+		want: []Entry{{
+			Name: "github",
+			Args: []string{"my-app"},
+			Flags: map[string]string{
+				"app-id": "123456",
+				"public": "false",
+			},
+		}, {
+			Name: "quay",
+			Flags: map[string]string{
+				"url":              "https://quay.io",
+				"token":            "env-token",
+				"dockerconfigjson": `{"auths":{}}`,
+			},
+		}},
+	}, {
+		name: "missing environment variable",
+		payload: `
+integrations:
+  quay:
+    token: ${TSSC_TEST_MISSING}
+`,
+		wantErr: "quay: token: environment variable(s) not set",
+	}, {
+		name: "missing file",
+		payload: `
+integrations:
+  quay:
+    dockerconfigjson: "@missing.json"
+`,
+		wantErr: "quay: dockerconfigjson:",
+	}, {
+		name: "missing file argument",
+		payload: `
+integrations:
+  github:
+    args: ["@missing.txt"]
+`,
+		wantErr: "github: args:",
+	}, {
+		name: "args not a list",
+		payload: `
+integrations:
+  github:
+    args: my-app
+`,
+		wantErr: "github: args: expected a list",
+	}, {
+		name: "not a scalar",
+		payload: `
+integrations:
+  quay:
+    url: {host: quay.io}
+`,
+		wantErr: "quay: url: expected a scalar",
+	}, {
+		name:    "no integrations",
+		payload: "integrations: {}\n",
+		wantErr: "no integrations informed",
+	}, {
+		name:    "invalid yaml",
+		payload: "integrations: [",
+		wantErr: "invalid integrations file",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadApplied(strings.NewReader(tt.payload), baseDir)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidApplied) ||
+					!strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadApplied() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadApplied() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("LoadApplied() = %+v, want %+v", got.Entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntry_Argv(t *testing.T) {
+	e := Entry{
+		Name:  "github",
+		Args:  []string{"my-app"},
+		Flags: map[string]string{"token": "t", "app-id": "1"},
+	}
+	want := []string{"--app-id=1", "--token=t", "my-app"}
+	if got := e.Argv(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Argv() = %v, want %v", got, want)
+	}
+
+	changed := Entry{Name: e.Name, Args: e.Args,
+		Flags: map[string]string{"token": "u", "app-id": "1"}}
+	if e.Hash() != e.Hash() || e.Hash() == changed.Hash() {
+		t.Error("Hash() must be stable, and change with the parameters")
+	}
+}
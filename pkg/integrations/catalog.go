@@ -0,0 +1,121 @@
+package integrations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/api/annotations"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+// ErrInvalidExpression the chart "integrations-required", or "condition", isn't
+// valid CEL.
+var ErrInvalidExpression = resolver.ErrInvalidExpression
+
+// Chart the integrations provided and required by a Helm chart.
+type Chart struct {
+	Name     string   // chart name
+	Product  string   // associated product, if any
+	Provided []string // integrations provided
+	Required string   // integrations required, CEL expression
+}
+
+// String returns the chart name, along with the product when associated.
+func (c *Chart) String() string {
+	if c.Product == "" {
+		return c.Name
+	}
+	return fmt.Sprintf("%s (%s)", c.Name, c.Product)
+}
+
+// Catalog the integrations provided and required by the installer charts.
+type Catalog struct {
+	charts []Chart       // charts with integrations annotations
+	cel    *resolver.CEL // framework CEL environment, with the integration names
+}
+
+// Charts returns the charts providing or requiring integrations.
+func (c *Catalog) Charts() []Chart {
+	return c.charts
+}
+
+// Referenced returns the integration names referenced by the expression, the
+// configuration variables are not part of it.
+func (c *Catalog) Referenced(expression string) ([]string, error) {
+	return c.cel.Referenced(expression)
+}
+
+// Satisfied evaluates the expression against the configuration and the
+// configured integrations, the same as the framework resolver does.
+func (c *Catalog) Satisfied(
+	expression string,
+	cfg *config.Config,
+	configured map[string]bool,
+) (bool, error) {
+	c.cel.SetConfig(cfg)
+	return c.cel.Satisfied(configured, expression)
+}
+
+// ProvidedBy returns the charts providing the integration.
+func (c *Catalog) ProvidedBy(name string) []Chart {
+	charts := []Chart{}
+	for _, hc := range c.charts {
+		if slices.Contains(hc.Provided, name) {
+			charts = append(charts, hc)
+		}
+	}
+	return charts
+}
+
+// RequiredBy returns the charts referencing the integration on the required
+// integrations expression.
+func (c *Catalog) RequiredBy(name string) ([]Chart, error) {
+	charts := []Chart{}
+	for _, hc := range c.charts {
+		if hc.Required == "" {
+			continue
+		}
+		referenced, err := c.Referenced(hc.Required)
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", hc.Name, err)
+		}
+		if slices.Contains(referenced, name) {
+			charts = append(charts, hc)
+		}
+	}
+	return charts, nil
+}
+
+// NewCatalog inspects the Helm charts integrations annotations, the integration
+// names are the ones registered on the framework.
+func NewCatalog(charts []chart.Chart, names []string) (*Catalog, error) {
+	env, err := resolver.NewCEL(names...)
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalog{cel: env}
+	for i := range charts {
+		a := charts[i].Metadata.Annotations
+		hc := Chart{
+			Name:     charts[i].Name(),
+			Product:  a[annotations.ProductName],
+			Required: strings.TrimSpace(a[annotations.IntegrationsRequired]),
+		}
+		for _, p := range strings.Split(a[annotations.IntegrationsProvided], ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				hc.Provided = append(hc.Provided, p)
+			}
+		}
+		if len(hc.Provided) == 0 && hc.Required == "" {
+			continue
+		}
+		c.charts = append(c.charts, hc)
+	}
+	slices.SortFunc(c.charts, func(a, b Chart) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return c, nil
+}
@@ -0,0 +1,140 @@
+package integrations
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Consumer a secret the charts render from the integration secret, copying some
+// of its keys, on a namespace of the installation.
+type Consumer struct {
+	Name       string                          // secret name
+	Keys       map[string]string               // integration secret key, by consumer key
+	Namespace  func(cfg *config.Config) string // secret namespace, for the installation
+	Deployment string                          // restarted to read the secret, optional
+}
+
+// productNamespace returns the namespace the product is deployed on, the
+// installer's when the product doesn't inform one.
+func productNamespace(name string) func(*config.Config) string {
+	return func(cfg *config.Config) string {
+		if p, err := cfg.GetProduct(name); err == nil && p.GetNamespace() != "" {
+			return p.GetNamespace()
+		}
+		return cfg.Namespace()
+	}
+}
+
+// GitHubConsumers the secrets carrying the GitHub App secrets: Pipelines-as-Code,
+// the application CI namespace and Developer Hub, which is restarted for the
+// environment to take effect.
+var GitHubConsumers = []Consumer{{
+	Name:      "pipelines-as-code-secret",
+	Keys:      map[string]string{"webhook.secret": "webhookSecret"},
+	Namespace: productNamespace("OpenShift Pipelines"),
+}, {
+	Name: "github-pipelines-secret",
+	Keys: map[string]string{"webhook.secret": "webhookSecret"},
+	// The application namespaces prefix, set by the values template.
+	Namespace: func(cfg *config.Config) string {
+		return fmt.Sprintf("%s-app-ci", cfg.Namespace())
+	},
+}, {
+	Name: "tssc-developer-hub-env",
+	Keys: map[string]string{
+		"GITHUB__APP__CLIENT__SECRET":  "clientSecret",
+		"GITHUB__APP__WEBHOOK__SECRET": "webhookSecret",
+	},
+	Namespace:  productNamespace("Developer Hub"),
+	Deployment: "backstage-developer-hub",
+}}
+
+// restart triggers the Deployment rollout, as "kubectl rollout restart" does. A
+// missing Deployment is not an error, the product may not be deployed yet.
+func restart(
+	ctx context.Context,
+	logger *slog.Logger,
+	cs kubernetes.Interface,
+	namespace, name string,
+) error {
+	patch := fmt.Sprintf(
+		`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		"kubectl.kubernetes.io/restartedAt", time.Now().Format(time.RFC3339))
+	_, err := cs.AppsV1().Deployments(namespace).Patch(ctx, name,
+		types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if apierrors.IsNotFound(err) {
+		logger.Warn("Deployment not found, not restarted",
+			"namespace", namespace, "name", name)
+		return nil
+	}
+	if err == nil {
+		logger.Info("Deployment restarted", "namespace", namespace, "name", name)
+	}
+	return err
+}
+
+// SyncConsumers updates the consumer secrets of the installation with the
+// integration secret data, only the keys the consumers already carry are
+// updated, the next deployment renders the same. It returns the number of
+// secrets updated.
+func SyncConsumers(
+	ctx context.Context,
+	logger *slog.Logger,
+	kube k8s.Interface,
+	cfg *config.Config,
+	consumers []Consumer,
+	data map[string][]byte,
+) (int, error) {
+	cs, err := kube.ClientSet("")
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, c := range consumers {
+		namespace := c.Namespace(cfg)
+		secret, err := cs.CoreV1().Secrets(namespace).
+			Get(ctx, c.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return updated, err
+		}
+		changed := false
+		for consumerKey, key := range c.Keys {
+			v, ok := data[key]
+			if _, exists := secret.Data[consumerKey]; !ok || !exists {
+				continue
+			}
+			secret.Data[consumerKey] = v
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		logger.Info("Updating the integration consumer secret",
+			"namespace", namespace, "name", c.Name)
+		_, err = cs.CoreV1().Secrets(namespace).
+			Update(ctx, secret, metav1.UpdateOptions{})
+		if err != nil {
+			return updated, err
+		}
+		updated++
+		if c.Deployment == "" {
+			continue
+		}
+		if err = restart(ctx, logger, cs, namespace, c.Deployment); err != nil {
+			return updated, err
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,111 @@
+package integrations
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// clientsetKube serves the informed clientset, keeping the writes.
+type clientsetKube struct {
+	*k8s.FakeKube
+	cs *fake.Clientset
+}
+
+func (k *clientsetKube) ClientSet(string) (kubernetes.Interface, error) {
+	return k.cs, nil
+}
+
+// envSecret the Developer Hub environment secret on the namespace.
+func envSecret(namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tssc-developer-hub-env", Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"GITHUB__APP__WEBHOOK__SECRET": []byte("old"),
+			"BACKEND_SECRET":               []byte("backend"),
+		},
+	}
+}
+
+func TestSyncConsumers(t *testing.T) {
+	payload, err := os.ReadFile("../../installer/config.yaml")
+	if err != nil {
+		t.Fatalf("reading the configuration: %v", err)
+	}
+	cfg, err := config.NewConfigFromBytes(payload, "tssc-blue", "tssc")
+	if err != nil {
+		t.Fatalf("parsing the configuration: %v", err)
+	}
+	if err = cfg.ScopeNamespaces("blue"); err != nil {
+		t.Fatalf("scoping the namespaces: %v", err)
+	}
+
+	cs := fake.NewClientset(
+		envSecret("tssc-dh-blue"),
+		// The default installation's, left as is.
+		envSecret("tssc-dh"),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelines-as-code-secret", Namespace: "tssc-blue",
+			},
+			Data: map[string][]byte{"webhook.secret": []byte("old")},
+		},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name: "backstage-developer-hub", Namespace: "tssc-dh-blue",
+		}},
+	)
+	kube := &clientsetKube{FakeKube: k8s.NewFakeKube(), cs: cs}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	n, err := SyncConsumers(context.TODO(), logger, kube, cfg, GitHubConsumers,
+		map[string][]byte{"webhookSecret": []byte("new")})
+	if err != nil {
+		t.Fatalf("SyncConsumers() = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("SyncConsumers() updated %d secrets, want 2", n)
+	}
+
+	for _, tt := range []struct {
+		namespace string // secret namespace
+		name      string // secret name
+		key       string // secret key
+		want      string // value expected
+	}{
+		{"tssc-dh-blue", "tssc-developer-hub-env", "GITHUB__APP__WEBHOOK__SECRET", "new"},
+		{"tssc-dh-blue", "tssc-developer-hub-env", "BACKEND_SECRET", "backend"},
+		{"tssc-dh", "tssc-developer-hub-env", "GITHUB__APP__WEBHOOK__SECRET", "old"},
+		{"tssc-blue", "pipelines-as-code-secret", "webhook.secret", "new"},
+	} {
+		secret, err := cs.CoreV1().Secrets(tt.namespace).
+			Get(context.TODO(), tt.name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("getting %s/%s: %v", tt.namespace, tt.name, err)
+		}
+		if got := string(secret.Data[tt.key]); got != tt.want {
+			t.Errorf("%s/%s %q = %q, want %q",
+				tt.namespace, tt.name, tt.key, got, tt.want)
+		}
+	}
+
+	deployment, err := cs.AppsV1().Deployments("tssc-dh-blue").
+		Get(context.TODO(), "backstage-developer-hub", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("getting the deployment: %v", err)
+	}
+	if deployment.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"] == "" {
+		t.Error("Developer Hub deployment isn't restarted")
+	}
+}
@@ -0,0 +1,63 @@
+package integrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Lookup returns the named integration registered on the framework, or an error
+// listing the registered ones.
+func Lookup(manager api.Integrations, name string) (*api.Integration, error) {
+	i, ok := manager.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown integration %q, expected one of %v",
+			name, manager.IntegrationNames())
+	}
+	return i, nil
+}
+
+// ConfiguredSecrets returns the integration secrets found on the installer
+// namespace, by integration name.
+func ConfiguredSecrets(
+	ctx context.Context,
+	manager api.Integrations,
+	cfg *config.Config,
+) (map[string]*corev1.Secret, error) {
+	secrets := map[string]*corev1.Secret{}
+	for _, name := range manager.IntegrationNames() {
+		i, _ := manager.Lookup(name)
+		secret, err := i.Get(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if secret != nil {
+			secrets[name] = secret
+		}
+	}
+	return secrets, nil
+}
+
+// Configured returns whether each registered integration is configured on the
+// installer namespace, the variables of the CEL expressions.
+func Configured(
+	ctx context.Context,
+	manager api.Integrations,
+	cfg *config.Config,
+) (map[string]bool, error) {
+	configured := map[string]bool{}
+	for _, name := range manager.IntegrationNames() {
+		configured[name] = false
+	}
+	names, err := manager.ConfiguredIntegrations(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		configured[name] = true
+	}
+	return configured, nil
+}
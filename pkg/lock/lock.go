@@ -0,0 +1,307 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/user"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redhat-appstudio/helmet/api/annotations"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Lock represents the cluster-wide installation lock, a "coordination.k8s.io"
+// Lease on the installer namespace. The holder renews the Lease while running,
+// an expired Lease is taken over by the next holder.
+type Lock struct {
+	logger    *slog.Logger  // application logger
+	kube      k8s.Interface // kubernetes client
+	name      string        // lease name
+	namespace string        // installer namespace
+	identity  string        // holder identity
+	command   string        // command holding the lock
+
+	mu     sync.Mutex              // guards the renewal state
+	cancel context.CancelFunc      // stops the renewal
+	lost   context.CancelCauseFunc // cancels the holder context
+	done   chan struct{}           // renewal stopped
+}
+
+const (
+	// Duration the lease duration, the holder renews it on a third of it.
+	Duration = 60 * time.Second
+
+	// commandAnnotation records the command holding the lock.
+	commandAnnotation = annotations.RepoURI + "/lock-command"
+)
+
+var (
+	// ErrLocked the installation is locked by another holder.
+	ErrLocked = errors.New("installation is locked")
+	// ErrLockLost the lock was taken over, or the lease expired without being
+	// renewed, while held.
+	ErrLockLost = errors.New("installation lock lost")
+)
+
+// Held asserts whether the lock is held by this instance.
+func (l *Lock) Held() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cancel != nil
+}
+
+// expired asserts whether the lease holder stopped renewing it.
+func expired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return true
+	}
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	duration := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	return lease.Spec.RenewTime.Add(duration).Before(time.Now())
+}
+
+// lockedErr describes the current lease holder.
+func lockedErr(lease *coordinationv1.Lease) error {
+	msg := fmt.Sprintf("held by %q", ptr.Deref(lease.Spec.HolderIdentity, ""))
+	if cmd := lease.GetAnnotations()[commandAnnotation]; cmd != "" {
+		msg = fmt.Sprintf("%s running %q", msg, cmd)
+	}
+	if lease.Spec.AcquireTime != nil {
+		msg = fmt.Sprintf("%s since %s", msg,
+			lease.Spec.AcquireTime.Format(time.RFC3339))
+	}
+	return fmt.Errorf(
+		"%w: %s, on lease %s/%s; wait for it to finish or use --break-lock",
+		ErrLocked, msg, lease.GetNamespace(), lease.GetName())
+}
+
+// hold stamps this instance as the lease holder.
+func (l *Lock) hold(lease *coordinationv1.Lease) {
+	now := metav1.NewMicroTime(time.Now())
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != l.identity {
+		lease.Spec.AcquireTime = &now
+		lease.Spec.LeaseTransitions = ptr.To(
+			ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+	}
+	lease.Spec.HolderIdentity = ptr.To(l.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(Duration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if lease.Annotations == nil {
+		lease.Annotations = map[string]string{}
+	}
+	lease.Annotations[commandAnnotation] = l.command
+}
+
+// Acquire takes the lock, failing when it's held by someone else, unless
+// "breakLock" is set. The lease is renewed until the lock is released. The
+// returned context, derived from the informed one, is cancelled with
+// ErrLockLost when the lock is lost meanwhile, the holder must stop.
+func (l *Lock) Acquire(
+	ctx context.Context,
+	breakLock bool,
+) (context.Context, error) {
+	if l.Held() {
+		return ctx, nil
+	}
+	cs, err := l.kube.ClientSet(l.namespace)
+	if err != nil {
+		return nil, err
+	}
+	leases := cs.CoordinationV1().Leases(l.namespace)
+	logger := l.logger.With("lease", l.name, "namespace", l.namespace)
+
+	lease, err := leases.Get(ctx, l.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: l.name, Namespace: l.namespace},
+		}
+		l.hold(lease)
+		logger.Debug("Creating the lock lease...")
+		if _, err = leases.Create(ctx, lease, metav1.CreateOptions{}); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return nil, fmt.Errorf(
+					"%w: acquired concurrently, try again", ErrLocked)
+			}
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+		if !expired(lease) && holder != l.identity {
+			if !breakLock {
+				return nil, lockedErr(lease)
+			}
+			logger.Warn("Breaking the lock", "holder", holder)
+		}
+		l.hold(lease)
+		logger.Debug("Taking over the lock lease...")
+		if _, err = leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+			if apierrors.IsConflict(err) {
+				return nil, fmt.Errorf(
+					"%w: acquired concurrently, try again", ErrLocked)
+			}
+			return nil, err
+		}
+	}
+
+	holderCtx, lost := context.WithCancelCause(ctx)
+	renewCtx, cancel := context.WithCancel(context.Background())
+	l.mu.Lock()
+	l.cancel = cancel
+	l.lost = lost
+	l.done = make(chan struct{})
+	l.mu.Unlock()
+	go l.renew(renewCtx, lost, l.done)
+	return holderCtx, nil
+}
+
+// renew keeps the lease renewed until the context is cancelled. When the lock is
+// taken over, or the lease expires while renewals fail, the holder context is
+// cancelled with ErrLockLost and the renewal stops.
+func (l *Lock) renew(
+	ctx context.Context,
+	lost context.CancelCauseFunc,
+	done chan struct{},
+) {
+	defer close(done)
+	ticker := time.NewTicker(Duration / 3)
+	defer ticker.Stop()
+	logger := l.logger.With("lease", l.name, "namespace", l.namespace)
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := l.renewOnce(ctx)
+			switch {
+			case err == nil:
+				renewed = time.Now()
+			case errors.Is(err, ErrLockLost):
+				logger.Error("Lock lost, stopping", "error", err)
+				lost(err)
+				return
+			case time.Since(renewed) >= Duration:
+				err = fmt.Errorf("%w: lease expired: %w", ErrLockLost, err)
+				logger.Error("Lock lost, stopping", "error", err)
+				lost(err)
+				return
+			default:
+				logger.Warn("Failed to renew the lock lease", "error", err)
+			}
+		}
+	}
+}
+
+// renewOnce bumps the lease renew time, failing with ErrLockLost when the lock
+// was broken.
+func (l *Lock) renewOnce(ctx context.Context) error {
+	cs, err := l.kube.ClientSet(l.namespace)
+	if err != nil {
+		return err
+	}
+	leases := cs.CoordinationV1().Leases(l.namespace)
+	lease, err := leases.Get(ctx, l.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: lease removed", ErrLockLost)
+	}
+	if err != nil {
+		return err
+	}
+	if holder := ptr.Deref(lease.Spec.HolderIdentity, ""); holder != l.identity {
+		return fmt.Errorf("%w: taken over by %q", ErrLockLost, holder)
+	}
+	l.hold(lease)
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// Release stops the renewal and deletes the lease, when still held by this
+// instance.
+func (l *Lock) Release() {
+	l.mu.Lock()
+	cancel, lost, done := l.cancel, l.lost, l.done
+	l.cancel, l.lost, l.done = nil, nil, nil
+	l.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+	lost(nil)
+
+	logger := l.logger.With("lease", l.name, "namespace", l.namespace)
+	ctx := context.Background()
+	cs, err := l.kube.ClientSet(l.namespace)
+	if err != nil {
+		logger.Warn("Failed to release the lock", "error", err)
+		return
+	}
+	leases := cs.CoordinationV1().Leases(l.namespace)
+	lease, err := leases.Get(ctx, l.name, metav1.GetOptions{})
+	if err != nil {
+		logger.Warn("Failed to release the lock", "error", err)
+		return
+	}
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != l.identity {
+		logger.Warn("Lock taken over, not releasing",
+			"holder", ptr.Deref(lease.Spec.HolderIdentity, ""))
+		return
+	}
+	logger.Debug("Releasing the lock...")
+	err = leases.Delete(ctx, l.name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			ResourceVersion: ptr.To(lease.GetResourceVersion()),
+		},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Warn("Failed to release the lock", "error", err)
+	}
+}
+
+// Identity returns the holder identity for this process, user, host and process
+// ID. Inside the cluster the hostname is the installer Job's pod name.
+func Identity() string {
+	username := "unknown"
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		username = u.Username
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s@%s (pid %d)", username, hostname, os.Getpid())
+}
+
+// NewLock instantiates the installation lock for the application, on the
+// installer namespace. The command is recorded on the lease, to inform others
+// what holds the lock.
+func NewLock(
+	logger *slog.Logger,
+	kube k8s.Interface,
+	appName string,
+	namespace string,
+	command []string,
+) *Lock {
+	return &Lock{
+		logger:    logger,
+		kube:      kube,
+		name:      fmt.Sprintf("%s-lock", appName),
+		namespace: namespace,
+		identity:  Identity(),
+		command:   strings.Join(command, " "),
+	}
+}
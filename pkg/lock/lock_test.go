@@ -0,0 +1,83 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/redhat-appstudio/helmet/api/k8s"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestLock_renewOnce(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	lease := func(holder string) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "tssc-lock", Namespace: "tssc"},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(Duration.Seconds())),
+				RenewTime:            ptr.To(metav1.NewMicroTime(time.Now())),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		lost    bool
+	}{
+		{name: "held", objects: []runtime.Object{lease(Identity())}},
+		{name: "taken over", objects: []runtime.Object{lease("other")}, lost: true},
+		{name: "removed", lost: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLock(logger, k8s.NewFakeKube(tt.objects...), "tssc", "tssc",
+				[]string{"tssc", "deploy"})
+			err := l.renewOnce(context.TODO())
+			if tt.lost {
+				if !errors.Is(err, ErrLockLost) {
+					t.Errorf("renewOnce() = %v, want %v", err, ErrLockLost)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("renewOnce() = %v, want success", err)
+			}
+		})
+	}
+}
+
+func TestLock_Release(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	l := NewLock(logger, k8s.NewFakeKube(), "tssc", "tssc",
+		[]string{"tssc", "deploy"})
+
+	ctx, err := l.Acquire(context.TODO(), false)
+	if err != nil {
+		t.Fatalf("Acquire() = %v", err)
+	}
+	if !l.Held() || ctx.Err() != nil {
+		t.Fatalf("lock not held after Acquire(), context error %v", ctx.Err())
+	}
+
+	// Releasing ends the holder context, without reporting the lock lost.
+	l.Release()
+	if l.Held() {
+		t.Error("lock held after Release()")
+	}
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		t.Errorf("holder context error = %v, want %v", ctx.Err(), context.Canceled)
+	}
+	if errors.Is(context.Cause(ctx), ErrLockLost) {
+		t.Errorf("holder context cause = %v after Release()", context.Cause(ctx))
+	}
+}
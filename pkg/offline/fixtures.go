@@ -0,0 +1,188 @@
+package offline
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/api/annotations"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// ErrInvalidFixture the fixture file doesn't contain valid Kubernetes objects.
+var ErrInvalidFixture = errors.New("invalid fixture")
+
+// OpenShiftFacts the OpenShift attributes the framework discovers for the values
+// template, empty on vanilla Kubernetes clusters.
+type OpenShiftFacts struct {
+	IngressDomain string `json:"ingressDomain"` // default ingress domain
+	RouterCA      string `json:"routerCA"`      // ingress router CA, base64
+	Version       string `json:"version"`       // cluster version
+}
+
+// decodeObjects decodes the YAML, or JSON, documents into objects, the "List"
+// documents are flattened into their items.
+func decodeObjects(r io.Reader) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		obj := map[string]any{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+			return nil, err
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: obj}
+		if u.GetKind() == "" || u.GetAPIVersion() == "" {
+			return nil, errors.New("apiVersion and kind are required")
+		}
+		if !u.IsList() {
+			if u.GetName() == "" {
+				return nil, fmt.Errorf("%s without metadata.name", u.GetKind())
+			}
+			objects = append(objects, u)
+			continue
+		}
+		if err := u.EachListItem(func(item runtime.Object) error {
+			objects = append(objects, item.(*unstructured.Unstructured))
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// LoadFixtures reads the Kubernetes objects on the YAML and JSON files of the
+// directory, recursively, served by the offline cluster instead of the API.
+func LoadFixtures(dir string) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		decoded, err := decodeObjects(f)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrInvalidFixture, path, err)
+		}
+		objects = append(objects, decoded...)
+		return nil
+	})
+	return objects, err
+}
+
+// EncodeCA returns the certificate base64 encoded, as the OpenShift facts carry
+// it, PEM payloads are encoded while base64 payloads are kept as is.
+func EncodeCA(ca string) string {
+	if strings.Contains(ca, "-----BEGIN") {
+		return base64.StdEncoding.EncodeToString([]byte(ca))
+	}
+	return strings.TrimSpace(ca)
+}
+
+// LoadFacts reads the OpenShift facts file, a YAML document with the
+// "ingressDomain", "routerCA" and "version" attributes. The router CA is either
+// base64 encoded or PEM.
+func LoadFacts(path string) (*OpenShiftFacts, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	facts := &OpenShiftFacts{}
+	if err = yaml.UnmarshalStrict(payload, facts); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidFixture, path, err)
+	}
+	facts.RouterCA = EncodeCA(facts.RouterCA)
+	return facts, nil
+}
+
+// FactsObjects returns the OpenShift objects the facts are discovered from: the
+// default ingress controller, the router CA secret and the cluster version.
+// Facts not informed are left out, as on vanilla Kubernetes.
+func FactsObjects(facts *OpenShiftFacts) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	if facts.IngressDomain != "" {
+		objects = append(objects, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "operator.openshift.io/v1",
+			"kind":       "IngressController",
+			"metadata": map[string]any{
+				"name":      "default",
+				"namespace": "openshift-ingress-operator",
+			},
+			"spec":   map[string]any{},
+			"status": map[string]any{"domain": facts.IngressDomain},
+		}})
+	}
+	if facts.RouterCA != "" {
+		// Validating the certificate encoding, the secret carries it decoded.
+		if _, err := base64.StdEncoding.DecodeString(facts.RouterCA); err != nil {
+			return nil, fmt.Errorf("router CA is not base64 encoded: %w", err)
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]any{
+				"name":      "router-ca",
+				"namespace": "openshift-ingress-operator",
+			},
+			"type": "kubernetes.io/tls",
+			"data": map[string]any{"tls.crt": facts.RouterCA},
+		}})
+	}
+	if facts.Version != "" {
+		objects = append(objects, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "config.openshift.io/v1",
+			"kind":       "ClusterVersion",
+			"metadata":   map[string]any{"name": "version"},
+			"status": map[string]any{
+				"desired": map[string]any{"version": facts.Version},
+			},
+		}})
+	}
+	return objects, nil
+}
+
+// ConfigObject returns the installer configuration ConfigMap, the same the
+// "config" subcommand creates for the installation instance, for the
+// configuration payload.
+func ConfigObject(
+	appName, instance, namespace string,
+	payload []byte,
+) *unstructured.Unstructured {
+	labels := map[string]any{annotations.Config: "true"}
+	if instance != "" {
+		labels[annotations.Instance] = instance
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      config.NewConfigMapManager(nil, appName, instance).Name(),
+			"namespace": namespace,
+			"labels":    labels,
+		},
+		"data": map[string]any{config.Filename: string(payload)},
+	}}
+}
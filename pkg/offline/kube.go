@@ -0,0 +1,20 @@
+package offline
+
+import (
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NewKube returns the Kubernetes client backed by the informed objects. It stands
+// in for the cluster when rendering offline: the installer configuration, the
+// OpenShift facts and the "lookup" template function are served from the
+// objects, objects absent are reported as not found. Later objects replace
+// earlier ones with the same kind, namespace and name.
+func NewKube(objects ...*unstructured.Unstructured) *k8s.FakeKube {
+	runtimeObjects := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		runtimeObjects = append(runtimeObjects, obj)
+	}
+	return k8s.NewFakeKube(runtimeObjects...)
+}
@@ -0,0 +1,280 @@
+package redact
+
+import (
+	"encoding/base64"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Placeholder replaces the sensitive values.
+const Placeholder = "<redacted>"
+
+// minValueLength shorter registered values are not masked, they would match
+// unrelated text.
+const minValueLength = 6
+
+// sensitiveKeys keys holding sensitive values, compared lowercase and without
+// separators.
+var sensitiveKeys = []string{
+	"apikey",
+	"apppassword",
+	"auth",
+	"clientsecret",
+	"credentials",
+	"dockerconfigjson",
+	"dockerconfigjsonreadonly",
+	"password",
+	"pem",
+	"privatekey",
+	"secret",
+	"token",
+	"webhooksecret",
+}
+
+// sensitiveSuffixes key suffixes holding sensitive values, for instance
+// "DB_PASSWORD" or "GITHUB__APP__CLIENT__SECRET".
+var sensitiveSuffixes = []string{
+	"apikey",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+}
+
+var (
+	// keyValuePattern YAML and properties "key: value" lines, the optional
+	// prefix covers list items and the values printer bullets.
+	keyValuePattern = regexp.MustCompile(
+		`^(\s*(?:[-*]\s+)?)("?)([^\s:"=][^:"=]*?)("?):(\s+)(.*)$`)
+	// logfmtPattern structured logger "key=value" attributes.
+	logfmtPattern = regexp.MustCompile(`(^|\s)([\w.-]+)=("(?:[^"\\]|\\.)*"|\S*)`)
+	// normalizeKeyPattern characters ignored comparing keys.
+	normalizeKeyPattern = regexp.MustCompile(`[^a-z0-9]`)
+)
+
+// Redactor masks sensitive values on text: Kubernetes Secret payloads, known
+// sensitive keys, and values registered as secret, such as the integration
+// secrets data.
+type Redactor struct {
+	mu      sync.RWMutex
+	values  []string    // registered secret values, longest first
+	enabled func() bool // whether redaction is enabled
+}
+
+// IsSensitiveKey tells whether the key holds a sensitive value, the last
+// segment of dotted keys is considered.
+func IsSensitiveKey(key string) bool {
+	if i := strings.LastIndex(key, "."); i >= 0 && i < len(key)-1 {
+		key = key[i+1:]
+	}
+	key = normalizeKeyPattern.ReplaceAllString(strings.ToLower(key), "")
+	if key == "" {
+		return false
+	}
+	if slices.Contains(sensitiveKeys, key) {
+		return true
+	}
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetEnabled informs the function telling whether redaction is enabled, it's
+// evaluated on each use, thus after the command-line is parsed.
+func (r *Redactor) SetEnabled(fn func() bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.enabled = fn
+}
+
+// Enabled tells whether redaction is enabled.
+func (r *Redactor) Enabled() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.enabled == nil || r.enabled()
+}
+
+// AddValues registers secret values, masked wherever they appear, as well as
+// their base64 encoding, the form they take on Secret manifests.
+func (r *Redactor) AddValues(values ...[]byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range values {
+		s := strings.TrimSpace(string(v))
+		if len(s) < minValueLength {
+			continue
+		}
+		// Multi-line values are printed line by line.
+		candidates := []string{s, base64.StdEncoding.EncodeToString(v)}
+		for _, l := range strings.Split(s, "\n") {
+			if l = strings.TrimSpace(l); len(l) >= minValueLength {
+				candidates = append(candidates, l)
+			}
+		}
+		for _, candidate := range candidates {
+			if !slices.Contains(r.values, candidate) {
+				r.values = append(r.values, candidate)
+			}
+		}
+	}
+	slices.SortFunc(r.values, func(a, b string) int {
+		return len(b) - len(a)
+	})
+}
+
+// maskValues replaces the registered secret values.
+func (r *Redactor) maskValues(line string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, v := range r.values {
+		line = strings.ReplaceAll(line, v, Placeholder)
+	}
+	return line
+}
+
+// maskValue returns the placeholder for non-empty values, keeping the YAML
+// block scalar indicator.
+func maskValue(value string) string {
+	v := strings.TrimSpace(value)
+	switch {
+	case v == "" || v == `""` || v == "''" || v == "{}" || v == "null":
+		return value
+	case strings.HasPrefix(v, "|") || strings.HasPrefix(v, ">"):
+		return v
+	}
+	return Placeholder
+}
+
+// indentation returns the line leading whitespace length.
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// state the multi-line context carried between lines: the current YAML
+// document kind, and the indentation of the block being masked.
+type state struct {
+	kind        string // current YAML document kind
+	blockIndent int    // masked block parent indentation, -1 when none
+	maskLines   bool   // the masked block replaces each line
+	privateKey  bool   // inside a PEM private key
+}
+
+// newState returns the initial state.
+func newState() *state {
+	return &state{blockIndent: -1}
+}
+
+// line masks a single line, updating the multi-line state.
+func (r *Redactor) line(s *state, line string) string {
+	if !r.Enabled() {
+		return line
+	}
+	trimmed := strings.TrimSpace(line)
+
+	// PEM private keys, wherever printed, only the armor lines are kept.
+	if s.privateKey {
+		if strings.Contains(line, "-----END ") {
+			s.privateKey = false
+			return line
+		}
+		return strings.Repeat(" ", indentation(line)) + Placeholder
+	}
+	if strings.Contains(line, "-----BEGIN ") &&
+		strings.Contains(line, "PRIVATE KEY-----") {
+		s.privateKey = !strings.Contains(line, "-----END ")
+		return r.maskValues(r.keyValue(line, false))
+	}
+
+	// Lines nested on a masked block: Secret payloads and block scalars.
+	if s.blockIndent >= 0 {
+		if trimmed == "" {
+			return line
+		}
+		if indentation(line) > s.blockIndent {
+			if s.maskLines {
+				return strings.Repeat(" ", indentation(line)) + Placeholder
+			}
+			return r.keyValue(line, true)
+		}
+		s.blockIndent, s.maskLines = -1, false
+	}
+
+	if trimmed == "---" {
+		s.kind = ""
+		return line
+	}
+	if k, ok := strings.CutPrefix(line, "kind:"); ok {
+		s.kind = strings.Trim(strings.TrimSpace(k), `"'`)
+	}
+	// Secret payload, the kind usually comes first, when not yet known the
+	// payload is masked as well.
+	if (s.kind == "" || s.kind == "Secret") &&
+		(trimmed == "data:" || trimmed == "stringData:") &&
+		indentation(line) == 0 {
+		s.blockIndent, s.maskLines = 0, false
+		return line
+	}
+
+	if strings.HasPrefix(trimmed, "time=") || strings.HasPrefix(trimmed, "level=") {
+		return r.maskValues(r.logfmt(line))
+	}
+	masked := r.keyValue(line, false)
+	if m := keyValuePattern.FindStringSubmatch(line); m != nil &&
+		IsSensitiveKey(m[3]) {
+		v := strings.TrimSpace(m[6])
+		if strings.HasPrefix(v, "|") || strings.HasPrefix(v, ">") {
+			s.blockIndent, s.maskLines = indentation(line), true
+		}
+	}
+	return r.maskValues(masked)
+}
+
+// keyValue masks the "key: value" line value, when the key is sensitive or all
+// keys are.
+func (r *Redactor) keyValue(line string, all bool) string {
+	m := keyValuePattern.FindStringSubmatch(line)
+	if m == nil {
+		if all {
+			return strings.Repeat(" ", indentation(line)) + Placeholder
+		}
+		return line
+	}
+	if !all && !IsSensitiveKey(m[3]) {
+		return line
+	}
+	return m[1] + m[2] + m[3] + m[4] + ":" + m[5] + maskValue(m[6])
+}
+
+// logfmt masks the sensitive structured logger attributes.
+func (r *Redactor) logfmt(line string) string {
+	return logfmtPattern.ReplaceAllStringFunc(line, func(attr string) string {
+		m := logfmtPattern.FindStringSubmatch(attr)
+		if !IsSensitiveKey(m[2]) || m[3] == "" || m[3] == `""` {
+			return attr
+		}
+		return m[1] + m[2] + "=" + Placeholder
+	})
+}
+
+// String masks the sensitive values on the text.
+func (r *Redactor) String(text string) string {
+	if !r.Enabled() {
+		return text
+	}
+	s := newState()
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = r.line(s, lines[i])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewRedactor instantiates the redactor, enabled by default.
+func NewRedactor() *Redactor {
+	return &Redactor{}
+}
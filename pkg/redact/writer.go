@@ -0,0 +1,124 @@
+package redact
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// partialLineDelay the time a partial line, such as an interactive prompt, is
+// held waiting for the rest of the line before it's written.
+const partialLineDelay = 100 * time.Millisecond
+
+// Writer masks the sensitive values written, line by line, before writing on
+// the underlying writer.
+type Writer struct {
+	mu  sync.Mutex
+	out io.Writer // underlying writer
+	r   *Redactor // redactor
+	s   *state    // multi-line state
+	buf []byte    // partial line
+}
+
+var _ io.Writer = (*Writer)(nil)
+
+// Write masks and writes the complete lines, the partial line is held until the
+// line is complete, or flushed.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := w.r.line(w.s, string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+		if _, err := io.WriteString(w.out, line+"\n"); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes the partial line, masked.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.buf) == 0 {
+		return nil
+	}
+	// The partial line doesn't change the multi-line state.
+	s := *w.s
+	line := w.r.line(&s, string(w.buf))
+	w.buf = w.buf[:0]
+	_, err := io.WriteString(w.out, line)
+	return err
+}
+
+// pending tells whether there's a partial line held.
+func (w *Writer) pending() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.buf) > 0
+}
+
+// ReadFrom copies the reader until EOF, partial lines are flushed when no more
+// data arrives in a short while.
+func (w *Writer) ReadFrom(src io.Reader) (int64, error) {
+	type chunk struct {
+		data []byte
+		err  error
+	}
+	chunks := make(chan chunk)
+	go func() {
+		for {
+			buf := make([]byte, 32*1024)
+			n, err := src.Read(buf)
+			chunks <- chunk{data: buf[:n], err: err}
+			if err != nil {
+				close(chunks)
+				return
+			}
+		}
+	}()
+
+	var total int64
+	for {
+		var timeout <-chan time.Time
+		if w.pending() {
+			timeout = time.After(partialLineDelay)
+		}
+		select {
+		case c, ok := <-chunks:
+			if !ok {
+				return total, w.Flush()
+			}
+			if len(c.data) > 0 {
+				n, err := w.Write(c.data)
+				total += int64(n)
+				if err != nil {
+					return total, err
+				}
+			}
+			if c.err == io.EOF {
+				continue
+			}
+			if c.err != nil {
+				_ = w.Flush()
+				return total, c.err
+			}
+		case <-timeout:
+			if err := w.Flush(); err != nil {
+				return total, err
+			}
+		}
+	}
+}
+
+// NewWriter instantiates a masking writer on the informed writer.
+func NewWriter(out io.Writer, r *Redactor) *Writer {
+	return &Writer{out: out, r: r, s: newState()}
+}
@@ -0,0 +1,54 @@
+package redact
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	t.Run("Write", func(t *testing.T) {
+		var b strings.Builder
+		w := NewWriter(&b, NewRedactor())
+
+		// The multi-line state is kept between writes, the partial line is held.
+		if _, err := w.Write([]byte("kind: Secret\ndata:\n  url: aHR0")); err != nil {
+			t.Fatalf("Write() = %v", err)
+		}
+		if want := "kind: Secret\ndata:\n"; b.String() != want {
+			t.Fatalf("written %q, want %q", b.String(), want)
+		}
+		_, err := w.Write([]byte("cHM6Ly9xdWF5Lmlv\ntype: Opaque\ntoken: abc"))
+		if err != nil {
+			t.Fatalf("Write() = %v", err)
+		}
+		want := "kind: Secret\ndata:\n  url: <redacted>\ntype: Opaque\n"
+		if b.String() != want {
+			t.Fatalf("written %q, want %q", b.String(), want)
+		}
+
+		if err = w.Flush(); err != nil {
+			t.Fatalf("Flush() = %v", err)
+		}
+		if !strings.HasSuffix(b.String(), "type: Opaque\ntoken: <redacted>") {
+			t.Errorf("flushed %q, want the partial line masked", b.String())
+		}
+	})
+
+	t.Run("ReadFrom", func(t *testing.T) {
+		var b strings.Builder
+		w := NewWriter(&b, NewRedactor())
+
+		text := "url: https://quay.io\npassword: abc"
+		// The partial line is flushed at the end of the stream.
+		n, err := w.ReadFrom(strings.NewReader(text))
+		if err != nil {
+			t.Fatalf("ReadFrom() = %v", err)
+		}
+		if n != int64(len(text)) {
+			t.Errorf("ReadFrom() read %d bytes, want %d", n, len(text))
+		}
+		if want := "url: https://quay.io\npassword: <redacted>"; b.String() != want {
+			t.Errorf("written %q, want %q", b.String(), want)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/release"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// manifestsFile the chart manifests file, on the chart directory.
+	manifestsFile = "manifests.yaml"
+	// hooksFile the chart Helm hooks file, on the chart directory.
+	hooksFile = "hooks.yaml"
+	// testsFile the chart tests, as PostSync hooks, on the chart directory.
+	testsFile = "tests.yaml"
+	// kustomizationFile the Kustomize entrypoint, on every directory.
+	kustomizationFile = "kustomization.yaml"
+	// argoCDDir the directory with the ArgoCD Applications, or ApplicationSet.
+	argoCDDir = "argocd"
+
+	// syncWaveAnnotation the ArgoCD sync wave annotation.
+	syncWaveAnnotation = "argocd.argoproj.io/sync-wave"
+	// waveLabel the Application label with the sync wave, for the ApplicationSet
+	// rolling sync steps.
+	waveLabel = "tssc.redhat-appstudio.github.com/wave"
+)
+
+// ErrInvalidLayout the GitOps layout options are invalid.
+var ErrInvalidLayout = errors.New("invalid GitOps layout")
+
+// GitOps the GitOps repository layout options.
+type GitOps struct {
+	Dir             string // output directory
+	RepoURL         string // GitOps repository URL
+	RepoPath        string // output directory path on the repository
+	TargetRevision  string // repository revision ArgoCD syncs
+	Project         string // ArgoCD project
+	ArgoCDNamespace string // namespace the ArgoCD Applications are created on
+	ApplicationSet  bool   // generates an ApplicationSet instead of Applications
+	Tests           bool   // includes the Helm tests as PostSync hooks
+}
+
+// Validate asserts the layout options.
+func (g *GitOps) Validate() error {
+	switch {
+	case g.Dir == "":
+		return fmt.Errorf("%w: the output directory is required", ErrInvalidLayout)
+	case g.RepoURL == "":
+		return fmt.Errorf("%w: the repository URL is required", ErrInvalidLayout)
+	case g.TargetRevision == "":
+		return fmt.Errorf("%w: the target revision is required", ErrInvalidLayout)
+	case g.Project == "":
+		return fmt.Errorf("%w: the ArgoCD project is required", ErrInvalidLayout)
+	case g.ArgoCDNamespace == "":
+		return fmt.Errorf("%w: the ArgoCD namespace is required", ErrInvalidLayout)
+	}
+	return nil
+}
+
+// isTest asserts whether the Helm hook is a chart test.
+func isTest(h *release.Hook) bool {
+	return slices.Contains(h.Events, release.HookTest)
+}
+
+// postSyncTest converts the Helm test into an ArgoCD PostSync hook, recreated on
+// every sync, ordered by the Helm hook weight.
+func postSyncTest(h *release.Hook) (string, error) {
+	obj := map[string]any{}
+	if err := yaml.Unmarshal([]byte(h.Manifest), &obj); err != nil {
+		return "", fmt.Errorf("parsing test %q: %w", h.Path, err)
+	}
+	metadata, _ := obj["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+		obj["metadata"] = metadata
+	}
+	annotations := map[string]any{}
+	if current, ok := metadata["annotations"].(map[string]any); ok {
+		for k, v := range current {
+			if !strings.HasPrefix(k, "helm.sh/hook") {
+				annotations[k] = v
+			}
+		}
+	}
+	annotations["argocd.argoproj.io/hook"] = "PostSync"
+	annotations["argocd.argoproj.io/hook-delete-policy"] = "BeforeHookCreation"
+	annotations[syncWaveAnnotation] = strconv.Itoa(h.Weight)
+	metadata["annotations"] = annotations
+	payload, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// document formats the manifest as a YAML document with its source path.
+func document(source, manifest string) string {
+	return fmt.Sprintf("---\n# Source: %s\n%s\n",
+		source, strings.TrimSpace(manifest))
+}
+
+// kustomization returns the Kustomization with the informed resources.
+func kustomization(resources []string) ([]byte, error) {
+	return yaml.Marshal(map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+}
+
+// writeFile writes the file on the layout directory.
+func (g *GitOps) writeFile(name string, payload []byte) error {
+	p := filepath.Join(g.Dir, name)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(p, payload, 0o644)
+}
+
+// writeRelease writes the release directory: the manifests, the Helm hooks kept
+// as such, ArgoCD maps them to sync phases, and the tests as PostSync hooks.
+func (g *GitOps) writeRelease(rel *Release) error {
+	files := map[string]*strings.Builder{
+		manifestsFile: {},
+		hooksFile:     {},
+		testsFile:     {},
+	}
+	files[manifestsFile].WriteString(rel.Manifest)
+	for _, h := range rel.Hooks {
+		if !isTest(h) {
+			files[hooksFile].WriteString(document(h.Path, h.Manifest))
+			continue
+		}
+		if !g.Tests {
+			continue
+		}
+		manifest, err := postSyncTest(h)
+		if err != nil {
+			return err
+		}
+		files[testsFile].WriteString(document(h.Path, manifest))
+	}
+
+	resources := []string{}
+	for _, name := range []string{manifestsFile, hooksFile, testsFile} {
+		if files[name].Len() == 0 {
+			continue
+		}
+		if err := g.writeFile(
+			path.Join(rel.Name, name), []byte(files[name].String()),
+		); err != nil {
+			return err
+		}
+		resources = append(resources, name)
+	}
+	payload, err := kustomization(resources)
+	if err != nil {
+		return err
+	}
+	return g.writeFile(path.Join(rel.Name, kustomizationFile), payload)
+}
+
+// syncPolicy the ArgoCD Application sync policy.
+func syncPolicy() map[string]any {
+	return map[string]any{
+		"automated": map[string]any{"prune": true, "selfHeal": true},
+		"syncOptions": []string{
+			"CreateNamespace=true",
+			"ServerSideApply=true",
+		},
+	}
+}
+
+// application returns the ArgoCD Application for the release, on its sync wave.
+func (g *GitOps) application(rel *Release, wave int) map[string]any {
+	return map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]any{
+			"name":      rel.Name,
+			"namespace": g.ArgoCDNamespace,
+			"annotations": map[string]string{
+				syncWaveAnnotation: strconv.Itoa(wave),
+			},
+			"labels": map[string]string{waveLabel: strconv.Itoa(wave)},
+		},
+		"spec": map[string]any{
+			"project": g.Project,
+			"source": map[string]any{
+				"repoURL":        g.RepoURL,
+				"targetRevision": g.TargetRevision,
+				"path":           path.Join(g.RepoPath, rel.Name),
+			},
+			"destination": map[string]any{
+				"server":    "https://kubernetes.default.svc",
+				"namespace": rel.Namespace,
+			},
+			"syncPolicy": syncPolicy(),
+		},
+	}
+}
+
+// applicationSet returns the ApplicationSet generating the Applications for the
+// releases, synced wave by wave with the rolling sync strategy.
+func (g *GitOps) applicationSet(name string, releases []*Release) map[string]any {
+	elements := []map[string]string{}
+	steps := []map[string]any{}
+	for i, rel := range releases {
+		wave := strconv.Itoa(i + 1)
+		elements = append(elements, map[string]string{
+			"name":      rel.Name,
+			"namespace": rel.Namespace,
+			"path":      path.Join(g.RepoPath, rel.Name),
+			"wave":      wave,
+		})
+		steps = append(steps, map[string]any{
+			"matchExpressions": []map[string]any{{
+				"key":      waveLabel,
+				"operator": "In",
+				"values":   []string{wave},
+			}},
+		})
+	}
+	return map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "ApplicationSet",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": g.ArgoCDNamespace,
+		},
+		"spec": map[string]any{
+			"goTemplate":        true,
+			"goTemplateOptions": []string{"missingkey=error"},
+			"generators": []map[string]any{{
+				"list": map[string]any{"elements": elements},
+			}},
+			"strategy": map[string]any{
+				"type":        "RollingSync",
+				"rollingSync": map[string]any{"steps": steps},
+			},
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"name": "{{ .name }}",
+					"annotations": map[string]string{
+						syncWaveAnnotation: "{{ .wave }}",
+					},
+					"labels": map[string]string{waveLabel: "{{ .wave }}"},
+				},
+				"spec": map[string]any{
+					"project": g.Project,
+					"source": map[string]any{
+						"repoURL":        g.RepoURL,
+						"targetRevision": g.TargetRevision,
+						"path":           "{{ .path }}",
+					},
+					"destination": map[string]any{
+						"server":    "https://kubernetes.default.svc",
+						"namespace": "{{ .namespace }}",
+					},
+					"syncPolicy": syncPolicy(),
+				},
+			},
+		},
+	}
+}
+
+// Write writes the GitOps layout for the releases, in topology order: a
+// directory per chart, the ArgoCD Applications, or ApplicationSet, with the
+// sync waves following the topology, and the root Kustomization referencing
+// them. The name identifies the ApplicationSet.
+func (g *GitOps) Write(name string, releases []*Release) error {
+	for _, rel := range releases {
+		if err := g.writeRelease(rel); err != nil {
+			return err
+		}
+	}
+
+	resources := []string{}
+	if g.ApplicationSet {
+		payload, err := yaml.Marshal(g.applicationSet(name, releases))
+		if err != nil {
+			return err
+		}
+		file := path.Join(argoCDDir, "applicationset.yaml")
+		if err = g.writeFile(file, payload); err != nil {
+			return err
+		}
+		resources = append(resources, file)
+	} else {
+		for i, rel := range releases {
+			payload, err := yaml.Marshal(g.application(rel, i+1))
+			if err != nil {
+				return err
+			}
+			file := path.Join(argoCDDir, rel.Name+".yaml")
+			if err = g.writeFile(file, payload); err != nil {
+				return err
+			}
+			resources = append(resources, file)
+		}
+	}
+	payload, err := kustomization(resources)
+	if err != nil {
+		return err
+	}
+	return g.writeFile(kustomizationFile, payload)
+}
@@ -0,0 +1,183 @@
+package render
+
+import (
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// update rewrites the golden files with the current output.
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// testReleases the releases in topology order, the second with a Helm hook and
+// a Helm test.
+func testReleases() []*Release {
+	return []*Release{{
+		Name:      "tssc-openshift",
+		Namespace: "tssc",
+		Manifest: `---
+# Source: tssc-openshift/templates/namespace.yaml
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: tssc-dh
+`,
+	}, {
+		Name:      "tssc-dh",
+		Namespace: "tssc-dh",
+		Manifest: `---
+# Source: tssc-dh/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: developer-hub
+  namespace: tssc-dh
+data:
+  url: https://backstage.example.com
+`,
+		Hooks: []*release.Hook{{
+			Path:   "tssc-dh/templates/hook.yaml",
+			Events: []release.HookEvent{release.HookPostInstall},
+			Manifest: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: developer-hub-setup
+  annotations:
+    helm.sh/hook: post-install
+`,
+		}, {
+			Path:   "tssc-dh/templates/tests/test.yaml",
+			Events: []release.HookEvent{release.HookTest},
+			Weight: 5,
+			Manifest: `apiVersion: v1
+kind: Pod
+metadata:
+  name: developer-hub-test
+  annotations:
+    helm.sh/hook: test
+    helm.sh/hook-delete-policy: before-hook-creation
+    tssc.redhat-appstudio.github.com/note: kept
+`,
+		}},
+	}}
+}
+
+// readTree reads the files under the directory, by relative path.
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		payload, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(payload)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("reading %s: %v", dir, err)
+	}
+	return files
+}
+
+// assertGolden compares the directory tree with the golden one, rewriting it
+// with "-update".
+func assertGolden(t *testing.T, dir, golden string) {
+	t.Helper()
+	got := readTree(t, dir)
+	if *update {
+		if err := os.RemoveAll(golden); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.CopyFS(golden, os.DirFS(dir)); err != nil {
+			t.Fatalf("updating %s: %v", golden, err)
+		}
+		return
+	}
+	want := readTree(t, golden)
+
+	names := []string{}
+	for name := range got {
+		names = append(names, name)
+	}
+	for name := range want {
+		if _, ok := got[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if got[name] == want[name] {
+			continue
+		}
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(want[name]),
+			B:        difflib.SplitLines(got[name]),
+			FromFile: "golden/" + name,
+			ToFile:   "rendered/" + name,
+			Context:  3,
+		})
+		t.Errorf("%s differs from the golden file:\n%s", name, diff)
+	}
+}
+
+func TestGitOps_Write(t *testing.T) {
+	tests := []struct {
+		name   string // test case, the golden directory
+		gitops GitOps // layout options, the directory is set by the test
+	}{{
+		name: "applications",
+		gitops: GitOps{
+			RepoURL:         "https://git.example.com/platform.git",
+			RepoPath:        "clusters/prod",
+			TargetRevision:  "main",
+			Project:         "platform",
+			ArgoCDNamespace: "openshift-gitops",
+		},
+	}, {
+		name: "applicationset",
+		gitops: GitOps{
+			RepoURL:         "https://git.example.com/platform.git",
+			RepoPath:        ".",
+			TargetRevision:  "HEAD",
+			Project:         "default",
+			ArgoCDNamespace: "openshift-gitops",
+			ApplicationSet:  true,
+			Tests:           true,
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.gitops.Dir = t.TempDir()
+			if err := tt.gitops.Validate(); err != nil {
+				t.Fatalf("Validate() = %v", err)
+			}
+			if err := tt.gitops.Write("tssc", testReleases()); err != nil {
+				t.Fatalf("Write() = %v", err)
+			}
+			assertGolden(t, tt.gitops.Dir,
+				filepath.Join("testdata", "gitops", tt.name))
+		})
+	}
+}
+
+func TestGitOps_Validate(t *testing.T) {
+	g := GitOps{Dir: "out", TargetRevision: "HEAD", Project: "default",
+		ArgoCDNamespace: "openshift-gitops"}
+	if err := g.Validate(); err == nil {
+		t.Error("Validate() without the repository URL: error expected")
+	}
+}
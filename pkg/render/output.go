@@ -0,0 +1,68 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hooks returns the release Helm hooks as YAML documents, tests included.
+func (r *Release) hooks() string {
+	var b strings.Builder
+	for _, h := range r.Hooks {
+		b.WriteString(document(h.Path, h.Manifest))
+	}
+	return b.String()
+}
+
+// WriteStream writes the releases as a single multi-document YAML stream, in
+// topology order, each release preceded by a comment header.
+func WriteStream(w io.Writer, releases []*Release) error {
+	for _, rel := range releases {
+		if _, err := fmt.Fprintf(w, "#\n# Release: %s (namespace %q)\n#\n%s%s",
+			rel.Name, rel.Namespace, rel.Manifest, rel.hooks()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tree returns the files of the per-chart directory tree, by relative path: a
+// directory per release, prefixed by the topology index, with the release
+// manifests and Helm hooks files.
+func Tree(releases []*Release) map[string]string {
+	files := map[string]string{}
+	for i, rel := range releases {
+		releaseDir := fmt.Sprintf("%02d-%s", i+1, rel.Name)
+		for name, payload := range map[string]string{
+			manifestsFile: rel.Manifest,
+			hooksFile:     rel.hooks(),
+		} {
+			if payload != "" {
+				files[filepath.Join(releaseDir, name)] = payload
+			}
+		}
+	}
+	return files
+}
+
+// WriteFiles writes the files, by relative path, on the directory.
+func WriteFiles(dir string, files map[string]string) error {
+	for name, payload := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(payload), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteTree writes the per-chart directory tree of the releases.
+func WriteTree(dir string, releases []*Release) error {
+	return WriteFiles(dir, Tree(releases))
+}
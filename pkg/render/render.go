@@ -0,0 +1,136 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/engine"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// Release the rendered manifests of a dependency.
+type Release struct {
+	Name      string          // release name, the scoped chart name
+	Namespace string          // target namespace
+	Manifest  string          // rendered manifests, CRDs included
+	Hooks     []*release.Hook // rendered Helm hooks, tests included
+}
+
+// Renderer renders the dependencies into plain manifests, the same as "deploy"
+// would install them. Rendering is client-side, the cluster is only read by the
+// charts "lookup" function, when a cluster client is informed.
+type Renderer struct {
+	logger *slog.Logger     // application logger
+	kube   k8s.Interface    // kubernetes client, optional
+	flags  *api.Flags       // global flags, the installation instance
+	values chartutil.Values // values passed to every chart
+}
+
+// Values renders the values template for the installer configuration and the
+// charts the topology deploys, the OpenShift facts, the cluster flavor and
+// ingress, and "lookup" are read from the cluster. The values are passed
+// to every chart, the same as "deploy" does.
+func Values(
+	ctx context.Context,
+	logger *slog.Logger,
+	kube k8s.Interface,
+	cfg *config.Config,
+	topology *resolver.Topology,
+	valuesTmpl string,
+) (chartutil.Values, error) {
+	variables := engine.NewVariables()
+	if err := variables.SetInstaller(cfg); err != nil {
+		return nil, err
+	}
+	variables.SetTopology(topology)
+	if err := variables.SetOpenShift(ctx, kube); err != nil {
+		return nil, err
+	}
+	if err := variables.SetCluster(ctx, logger, kube, cfg); err != nil {
+		return nil, err
+	}
+	payload, err := engine.NewEngine(kube, valuesTmpl).Render(variables)
+	if err != nil {
+		return nil, fmt.Errorf("rendering %s: %w", engine.ValuesFilename, err)
+	}
+	return chartutil.ReadValues(payload)
+}
+
+// Render renders the dependency Helm chart on its namespace, as "helm template"
+// does, the chart lookups read the cluster when available.
+func (r *Renderer) Render(
+	ctx context.Context,
+	d *resolver.Dependency,
+) (*Release, error) {
+	logger := d.LoggerWith(r.logger)
+	actionCfg := &action.Configuration{
+		Log: func(format string, v ...any) {
+			logger.WithGroup("helm-cli").Debug(fmt.Sprintf(format, v...))
+		},
+	}
+	c := action.NewInstall(actionCfg)
+	c.ReleaseName = r.flags.Scoped(d.Name())
+	c.Namespace = d.Namespace()
+	c.DryRun = true
+	c.ClientOnly = true
+	c.Replace = true
+	c.IncludeCRDs = true
+	if r.kube != nil {
+		// Client-only rendering, but the chart lookups read the cluster, the
+		// same as the framework's dry-run.
+		actionCfg.RESTClientGetter = r.kube.RESTClientGetter(d.Namespace())
+		c.DryRunOption = "server"
+	}
+
+	logger.Debug("Rendering the Helm chart")
+	rel, err := c.RunWithContext(ctx, d.Chart(), r.values)
+	if err != nil {
+		return nil, fmt.Errorf("rendering %q: %w", d.Name(), err)
+	}
+	hooks := slices.Clone(rel.Hooks)
+	slices.SortStableFunc(hooks, func(a, b *release.Hook) int {
+		return a.Weight - b.Weight
+	})
+	return &Release{
+		Name:      c.ReleaseName,
+		Namespace: d.Namespace(),
+		Manifest:  rel.Manifest,
+		Hooks:     hooks,
+	}, nil
+}
+
+// RenderTopology renders every dependency of the topology, in order.
+func (r *Renderer) RenderTopology(
+	ctx context.Context,
+	t *resolver.Topology,
+) ([]*Release, error) {
+	releases := []*Release{}
+	for _, d := range t.Dependencies() {
+		rel, err := r.Render(ctx, &d)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, rel)
+	}
+	return releases, nil
+}
+
+// NewRenderer instantiates the renderer with the values passed to every chart,
+// without a cluster client the chart lookups return empty. The release names are
+// scoped by the flags installation instance.
+func NewRenderer(
+	logger *slog.Logger,
+	kube k8s.Interface,
+	f *api.Flags,
+	values chartutil.Values,
+) *Renderer {
+	return &Renderer{logger: logger, kube: kube, flags: f, values: values}
+}
@@ -0,0 +1,29 @@
+package runcontext
+
+import (
+	"github.com/redhat-appstudio/tssc-cli/pkg/redact"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/framework"
+)
+
+// RunContext carries runtime dependencies for the tssc-specific subcommands: the
+// framework's run context, with the Kubernetes client, installer filesystem,
+// global flags and logger, the integrations registered on the framework, and the
+// redactor masking sensitive values on the output.
+type RunContext struct {
+	*api.RunContext
+
+	Integrations api.Integrations
+	Redactor     *redact.Redactor
+}
+
+// NewRunContext builds a RunContext sharing the framework's run context, the
+// redactor masks the sensitive values printed.
+func NewRunContext(app *framework.App, r *redact.Redactor) *RunContext {
+	return &RunContext{
+		RunContext:   app.RunContext(),
+		Integrations: app.Integrations(),
+		Redactor:     r,
+	}
+}
@@ -0,0 +1,139 @@
+package secretoutput
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Scope the SealedSecret scope, it determines the encryption label, thus where
+// the sealed secret can be unsealed.
+type Scope string
+
+const (
+	// ScopeStrict the secret is unsealed only with the same name and namespace.
+	ScopeStrict Scope = "strict"
+	// ScopeNamespaceWide the secret is unsealed with any name on the namespace.
+	ScopeNamespaceWide Scope = "namespace-wide"
+	// ScopeClusterWide the secret is unsealed anywhere.
+	ScopeClusterWide Scope = "cluster-wide"
+)
+
+// Scopes the supported SealedSecret scopes.
+var Scopes = []Scope{ScopeStrict, ScopeNamespaceWide, ScopeClusterWide}
+
+// sessionKeyLength the AES-256 session key length.
+const sessionKeyLength = 32
+
+// ParseCertificate parses the SealedSecret controller certificate, PEM encoded,
+// as shown by "kubeseal --fetch-cert", returning the RSA public key.
+func ParseCertificate(payload []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(payload)
+	if block == nil {
+		return nil, fmt.Errorf("%w: certificate is not PEM encoded",
+			ErrInvalidOptions)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: certificate public key is not RSA",
+			ErrInvalidOptions)
+	}
+	return key, nil
+}
+
+// label returns the encryption label for the scope.
+func label(secret *corev1.Secret, scope Scope) []byte {
+	switch scope {
+	case ScopeClusterWide:
+		return []byte{}
+	case ScopeNamespaceWide:
+		return []byte(secret.GetNamespace())
+	}
+	return []byte(fmt.Sprintf("%s/%s", secret.GetNamespace(), secret.GetName()))
+}
+
+// hybridEncrypt encrypts the plaintext the same as the SealedSecret controller
+// expects: a random session key encrypted with RSA-OAEP, prefixed by its
+// length, followed by the plaintext encrypted with AES-GCM using the session
+// key and a zero nonce, the session key is never reused.
+func hybridEncrypt(key *rsa.PublicKey, plaintext, label []byte) ([]byte, error) {
+	sessionKey := make([]byte, sessionKeyLength)
+	if _, err := rand.Read(sessionKey); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := rsa.EncryptOAEP(
+		sha256.New(), rand.Reader, key, sessionKey, label)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := binary.BigEndian.AppendUint16(nil, uint16(len(encryptedKey)))
+	ciphertext = append(ciphertext, encryptedKey...)
+	nonce := make([]byte, aead.NonceSize())
+	return aead.Seal(ciphertext, nonce, plaintext, nil), nil
+}
+
+// sealedSecret renders the SealedSecret, each secret value is encrypted with the
+// controller public key.
+func sealedSecret(secret *corev1.Secret, o *Options) ([]byte, error) {
+	key, err := ParseCertificate(o.SealedSecretCert)
+	if err != nil {
+		return nil, err
+	}
+	l := label(secret, o.SealedSecretScope)
+	encrypted := map[string]string{}
+	for _, k := range slices.Sorted(maps.Keys(secret.Data)) {
+		ciphertext, err := hybridEncrypt(key, secret.Data[k], l)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[k] = base64.StdEncoding.EncodeToString(ciphertext)
+	}
+
+	meta := metadata(secret)
+	switch o.SealedSecretScope {
+	case ScopeNamespaceWide:
+		meta["annotations"] = map[string]string{
+			"sealedsecrets.bitnami.com/namespace-wide": "true",
+		}
+	case ScopeClusterWide:
+		meta["annotations"] = map[string]string{
+			"sealedsecrets.bitnami.com/cluster-wide": "true",
+		}
+	}
+	return yaml.Marshal(map[string]any{
+		"apiVersion": "bitnami.com/v1alpha1",
+		"kind":       "SealedSecret",
+		"metadata":   meta,
+		"spec": map[string]any{
+			"encryptedData": encrypted,
+			"template": map[string]any{
+				"metadata": metadata(secret),
+				"type":     secretType(secret),
+			},
+		},
+	})
+}
@@ -0,0 +1,184 @@
+package secretoutput
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Format the integration secret output format, the secret is written as a
+// manifest instead of applied on the cluster, for GitOps managed clusters.
+type Format string
+
+const (
+	// FormatSealedSecret a Bitnami SealedSecret, encrypted with the controller
+	// public certificate.
+	FormatSealedSecret Format = "sealed-secret"
+	// FormatExternalSecret an External Secrets Operator ExternalSecret, the
+	// values are read from the secret store.
+	FormatExternalSecret Format = "external-secret"
+	// FormatSOPS a Secret manifest encrypted with SOPS.
+	FormatSOPS Format = "sops"
+)
+
+// Formats the supported output formats.
+var Formats = []Format{FormatSealedSecret, FormatExternalSecret, FormatSOPS}
+
+// ErrInvalidOptions the output options are invalid.
+var ErrInvalidOptions = errors.New("invalid secret output options")
+
+// Options the output format settings.
+type Options struct {
+	Format Format // output format
+
+	SealedSecretCert  []byte // SealedSecret controller public certificate, PEM
+	SealedSecretScope Scope  // SealedSecret scope
+
+	SecretStore     string // ExternalSecret store name
+	SecretStoreKind string // ExternalSecret store kind
+	SecretStorePath string // ExternalSecret remote key, secret store path
+
+	SOPSArgs []string // additional "sops encrypt" arguments, such as recipients
+	SOPSPath string   // output file path, matching ".sops.yaml" rules
+}
+
+// Validate asserts the options for the informed format.
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatSealedSecret:
+		if len(o.SealedSecretCert) == 0 {
+			return fmt.Errorf("%w: the SealedSecret certificate is required",
+				ErrInvalidOptions)
+		}
+		if !slices.Contains(Scopes, o.SealedSecretScope) {
+			return fmt.Errorf("%w: invalid SealedSecret scope %q, expected %v",
+				ErrInvalidOptions, o.SealedSecretScope, Scopes)
+		}
+		_, err := ParseCertificate(o.SealedSecretCert)
+		return err
+	case FormatExternalSecret:
+		if o.SecretStore == "" || o.SecretStorePath == "" {
+			return fmt.Errorf("%w: the secret store and path are required",
+				ErrInvalidOptions)
+		}
+		if o.SecretStoreKind != "SecretStore" &&
+			o.SecretStoreKind != "ClusterSecretStore" {
+			return fmt.Errorf("%w: invalid secret store kind %q",
+				ErrInvalidOptions, o.SecretStoreKind)
+		}
+	case FormatSOPS:
+		if _, err := exec.LookPath("sops"); err != nil {
+			return fmt.Errorf("%w: sops: %w", ErrInvalidOptions, err)
+		}
+	default:
+		return fmt.Errorf("%w: unknown format %q, expected one of %v",
+			ErrInvalidOptions, o.Format, Formats)
+	}
+	return nil
+}
+
+// metadata the manifest object metadata.
+func metadata(secret *corev1.Secret) map[string]any {
+	return map[string]any{
+		"name":      secret.GetName(),
+		"namespace": secret.GetNamespace(),
+	}
+}
+
+// secretType returns the secret type, opaque by default.
+func secretType(secret *corev1.Secret) corev1.SecretType {
+	if secret.Type == "" {
+		return corev1.SecretTypeOpaque
+	}
+	return secret.Type
+}
+
+// externalSecret renders the ExternalSecret, each secret key is a property of
+// the remote key on the secret store.
+func externalSecret(secret *corev1.Secret, o *Options) ([]byte, error) {
+	data := []map[string]any{}
+	for _, key := range slices.Sorted(maps.Keys(secret.Data)) {
+		data = append(data, map[string]any{
+			"secretKey": key,
+			"remoteRef": map[string]any{
+				"key":      o.SecretStorePath,
+				"property": key,
+			},
+		})
+	}
+	return yaml.Marshal(map[string]any{
+		"apiVersion": "external-secrets.io/v1",
+		"kind":       "ExternalSecret",
+		"metadata":   metadata(secret),
+		"spec": map[string]any{
+			"refreshInterval": "1h",
+			"secretStoreRef": map[string]any{
+				"name": o.SecretStore,
+				"kind": o.SecretStoreKind,
+			},
+			"target": map[string]any{
+				"name":           secret.GetName(),
+				"creationPolicy": "Owner",
+				"template": map[string]any{
+					"type": secretType(secret),
+				},
+			},
+			"data": data,
+		},
+	})
+}
+
+// sops renders the Secret manifest encrypted with SOPS, only the payload is
+// encrypted.
+func sops(secret *corev1.Secret, o *Options) ([]byte, error) {
+	plain := &corev1.Secret{
+		Data: secret.Data,
+		Type: secretType(secret),
+	}
+	plain.Kind, plain.APIVersion = "Secret", "v1"
+	plain.Name, plain.Namespace = secret.GetName(), secret.GetNamespace()
+	payload, err := yaml.Marshal(plain)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{
+		"encrypt",
+		"--input-type", "yaml",
+		"--output-type", "yaml",
+		"--encrypted-regex", "^(data|stringData)$",
+	}
+	if o.SOPSPath != "" {
+		args = append(args, "--filename-override", o.SOPSPath)
+	}
+	args = append(args, o.SOPSArgs...)
+	args = append(args, "/dev/stdin")
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("sops", args...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("sops: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// Render renders the integration secret on the informed format, keeping the
+// secret name, namespace and type the charts expect.
+func Render(secret *corev1.Secret, o *Options) ([]byte, error) {
+	switch o.Format {
+	case FormatSealedSecret:
+		return sealedSecret(secret, o)
+	case FormatExternalSecret:
+		return externalSecret(secret, o)
+	case FormatSOPS:
+		return sops(secret, o)
+	}
+	return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidOptions, o.Format)
+}
@@ -0,0 +1,170 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/charttest"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/chartfs"
+	"github.com/spf13/cobra"
+)
+
+// ChartTest represents the "chart test" subcommand, it renders the golden-file
+// test cases of the installer charts and compares them with the golden files.
+type ChartTest struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	suite *charttest.Suite // test suite
+	cases []string         // cases to run
+
+	installerDir string   // local installer directory
+	only         []string // case names filter
+	update       bool     // rewrite the golden files
+}
+
+var _ api.SubCommand = (*ChartTest)(nil)
+
+// ErrChartTestFailed the chart test cases failed.
+var ErrChartTestFailed = errors.New("chart test failed")
+
+const chartTestDesc = `
+Renders the installer charts for each test case of the suite directory, offline,
+and compares the manifests with the golden files checked in on the case.
+
+Each case is a directory with a "config.yaml", the installer configuration for
+the case: the products enabled, CRC settings, Developer Hub authentication
+provider, and so forth. The OpenShift facts, "facts.yaml", and the objects served
+to the "lookup" template function, "fixtures/", are shared by the cases on the
+suite directory, and can be informed per case as well. The manifests are written
+on the case "golden" directory, a directory per chart in topology order.
+
+Values generated on each rendering, like random passwords, are masked. Besides
+the golden files, invariants are asserted: container images without empty tags,
+and every namespace targeted being declared.
+
+Use "--update" to rewrite the golden files after intended changes, and review the
+differences before committing. The embedded installer charts are tested by
+default, use "--installer-dir" to test a local installer directory instead.
+`
+
+// Cmd exposes the cobra instance.
+func (c *ChartTest) Cmd() *cobra.Command {
+	return c.cmd
+}
+
+// log logger with contextual information.
+func (c *ChartTest) log() *slog.Logger {
+	return c.runCtx.Flags.LoggerWith(c.runCtx.Logger.With(
+		"installer-dir", c.installerDir,
+		"update", c.update,
+	))
+}
+
+// Complete instantiates the suite on the informed directory, and selects the
+// cases to run.
+func (c *ChartTest) Complete(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expecting the suite directory, got %d arguments",
+			len(args))
+	}
+	cfs := c.runCtx.ChartFS
+	if c.installerDir != "" {
+		cfs = chartfs.New(os.DirFS(c.installerDir))
+	}
+	c.suite = charttest.NewSuite(
+		c.log(), cfs, c.appCtx.Name, c.appCtx.Namespace, args[0])
+
+	cases, err := c.suite.Cases()
+	if err != nil {
+		return err
+	}
+	for _, name := range c.only {
+		if !slices.Contains(cases, name) {
+			return fmt.Errorf("case %q not found on %q", name, args[0])
+		}
+	}
+	c.cases = cases
+	if len(c.only) > 0 {
+		c.cases = c.only
+	}
+	return nil
+}
+
+// Validate asserts the cases to run.
+func (c *ChartTest) Validate() error {
+	if len(c.cases) == 0 {
+		return errors.New("no chart test cases to run")
+	}
+	return nil
+}
+
+// Run renders the cases, printing the outcome of each.
+func (c *ChartTest) Run() error {
+	failed := 0
+	for _, name := range c.cases {
+		result, err := c.suite.Run(c.cmd.Context(), name, c.update)
+		if err != nil {
+			return err
+		}
+		switch {
+		case result.Failed():
+			failed++
+			fmt.Fprintf(c.runCtx.Out, "FAIL %s\n%s", name, result)
+		case result.Updated:
+			fmt.Fprintf(c.runCtx.Out, "UPDATED %s\n", name)
+		default:
+			fmt.Fprintf(c.runCtx.Out, "PASS %s\n", name)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d case(s)", ErrChartTestFailed,
+			failed, len(c.cases))
+	}
+	return nil
+}
+
+// NewChartTest instantiates the "chart test" subcommand.
+func NewChartTest(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *ChartTest {
+	c := &ChartTest{
+		cmd: &cobra.Command{
+			Use:          "test DIR",
+			Short:        "Tests the installer charts against golden files",
+			Long:         chartTestDesc,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+	p := c.cmd.PersistentFlags()
+	p.StringVar(&c.installerDir, "installer-dir", c.installerDir,
+		"Local installer directory, instead of the embedded installer")
+	p.StringSliceVar(&c.only, "case", c.only, "Run only the informed cases")
+	p.BoolVar(&c.update, "update", c.update, "Rewrite the golden files")
+	return c
+}
+
+// chartCommands registers the "chart" subcommand, for installer charts
+// development.
+func chartCommands(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	chart := &cobra.Command{
+		Use:   "chart",
+		Short: "Installer charts development tools",
+	}
+	chart.AddCommand(api.NewRunner(NewChartTest(appCtx, runCtx)).Cmd())
+	root.AddCommand(chart)
+}
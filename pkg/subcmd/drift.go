@@ -0,0 +1,153 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/drift"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+// Drift represents the "drift" subcommand, it reports the differences between
+// the Helm releases and the live cluster state.
+type Drift struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	cfg      *config.Config    // installer configuration
+	charts   []chart.Chart     // charts to inspect
+	releases map[string]string // chart name and release namespace
+
+	failOnDrift bool // returns error when drift is detected
+}
+
+var _ api.SubCommand = (*Drift)(nil)
+
+const driftDesc = `
+Reports the drift between the deployed Helm releases and the live cluster state.
+
+Each release resource is compared with the live object, field by field. Only the
+fields on the release manifest are compared, fields populated by the API server,
+admission webhooks and controllers are ignored. Changes made by hand on the
+cluster are reverted by the next deployment, this command shows them beforehand.
+
+Secret values are never shown, only the path of the drifted entries.
+
+By default all deployed releases are inspected, or only the informed charts. Use
+"--fail-on-drift" to run it as a scheduled check, it exits with error when drift
+is detected.
+`
+
+// Cmd exposes the cobra instance.
+func (d *Drift) Cmd() *cobra.Command {
+	return d.cmd
+}
+
+// log logger with contextual information.
+func (d *Drift) log() *slog.Logger {
+	return d.runCtx.Flags.LoggerWith(
+		d.runCtx.Logger.With("fail-on-drift", d.failOnDrift))
+}
+
+// Complete loads the cluster configuration and the releases to inspect.
+func (d *Drift) Complete(args []string) error {
+	var err error
+	manager := config.NewConfigMapManager(
+		d.runCtx.Kube, d.appCtx.Name, d.runCtx.Flags.Instance)
+	if d.cfg, err = manager.GetConfig(d.cmd.Context()); err != nil {
+		return err
+	}
+
+	charts, err := d.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	d.charts = []chart.Chart{}
+	d.releases = map[string]string{}
+	for i := range charts {
+		name := charts[i].Name()
+		if len(args) > 0 && !slices.Contains(args, name) {
+			continue
+		}
+		if d.releases[name], err = topology.ChartNamespace(d.cfg, &charts[i]); err != nil {
+			return err
+		}
+		d.charts = append(d.charts, charts[i])
+	}
+	for _, name := range args {
+		if _, ok := d.releases[name]; !ok {
+			return fmt.Errorf("chart %q not found", name)
+		}
+	}
+	return nil
+}
+
+// Validate asserts the releases to inspect.
+func (d *Drift) Validate() error {
+	if len(d.releases) == 0 {
+		return errors.New("no charts found to inspect")
+	}
+	return nil
+}
+
+// Run inspects the releases, printing the drift report.
+func (d *Drift) Run() error {
+	detector := drift.NewDetector(d.log(), d.runCtx.Flags, d.runCtx.Kube)
+
+	slices.SortFunc(d.charts, func(a, b chart.Chart) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	drifted := 0
+	for i := range d.charts {
+		name := d.charts[i].Name()
+		namespace := d.releases[name]
+		rel, err := detector.Inspect(d.cmd.Context(), namespace, &d.charts[i])
+		if err != nil {
+			if errors.Is(err, driver.ErrReleaseNotFound) {
+				d.log().Debug("Release not deployed, skipping",
+					"release", name, "namespace", namespace)
+				continue
+			}
+			return err
+		}
+		rel.Print(d.runCtx.Out)
+		drifted += len(rel.Resources)
+	}
+
+	if drifted > 0 && d.failOnDrift {
+		return fmt.Errorf("%w: %d resource(s)", drift.ErrDriftDetected, drifted)
+	}
+	return nil
+}
+
+// NewDrift instantiates the "drift" subcommand.
+func NewDrift(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *Drift {
+	d := &Drift{
+		cmd: &cobra.Command{
+			Use:          "drift [chart...]",
+			Short:        "Reports drift between releases and the cluster state",
+			Long:         driftDesc,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+	d.cmd.PersistentFlags().BoolVar(&d.failOnDrift, "fail-on-drift",
+		d.failOnDrift, "Exit with error when drift is detected")
+	return d
+}
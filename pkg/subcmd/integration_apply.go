@@ -0,0 +1,280 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// applyAction what "integration apply" does with each integration.
+type applyAction string
+
+const (
+	applyCreate    applyAction = "create"
+	applyUpdate    applyAction = "update"
+	applyUnchanged applyAction = "unchanged"
+)
+
+// applyItem an integration from the integrations file, and its subcommand.
+type applyItem struct {
+	entry       integrations.Entry // integrations file entry
+	integration *api.Integration   // framework integration
+	cmd         *cobra.Command     // "integration <name>" subcommand
+	action      applyAction        // planned action
+	hash        string             // entry parameters digest
+}
+
+// IntegrationApply represents the "integration apply" subcommand, it configures
+// the integrations declared on a file at once.
+type IntegrationApply struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	filename string                // integrations file, "-" for stdin
+	cfg      *config.Config        // installer configuration
+	applied  *integrations.Applied // integrations file contents
+	items    []*applyItem          // integrations plan
+}
+
+var _ api.SubCommand = (*IntegrationApply)(nil)
+
+const integrationApplyDesc = `
+Applies the integrations declared on a YAML file, or stdin, each entry carries the
+flags of the respective "integration <name>" subcommand, and its positional
+arguments under "args":
+
+  integrations:
+    quay:
+      url: https://quay.io
+      token: ${QUAY_TOKEN}
+      dockerconfigjson: "@quay-auth.json"
+    acs:
+      endpoint: central.example.com:443
+      token: ${ACS_TOKEN}
+
+Values are resolved from environment variables, "${ENV}", and from files, "@file"
+relative to the integrations file location ("@@" escapes a literal "@").
+
+All integrations are validated before any secret is written. Only new or changed
+integrations are stored, the applied parameters digest is recorded on the
+integration secret; existing secrets are overwritten when changed.
+`
+
+// Cmd exposes the cobra instance.
+func (a *IntegrationApply) Cmd() *cobra.Command {
+	return a.cmd
+}
+
+// Complete loads the cluster configuration and the integrations file, and plans
+// the action for each integration comparing with the stored secrets.
+func (a *IntegrationApply) Complete(_ []string) error {
+	ctx := a.cmd.Context()
+	var err error
+	manager := config.NewConfigMapManager(
+		a.runCtx.Kube, a.appCtx.Name, a.runCtx.Flags.Instance)
+	if a.cfg, err = manager.GetConfig(ctx); err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	baseDir, _ := os.Getwd()
+	if a.filename != "-" {
+		f, err := os.Open(a.filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, baseDir = f, filepath.Dir(a.filename)
+	}
+	if a.applied, err = integrations.LoadApplied(r, baseDir); err != nil {
+		return err
+	}
+
+	integration := a.cmd.Parent()
+	a.items = nil
+	for _, entry := range a.applied.Entries {
+		item := &applyItem{entry: entry, hash: entry.Hash()}
+		i, ok := a.runCtx.Integrations.Lookup(entry.Name)
+		cmd, _, err := integration.Find([]string{entry.Name})
+		if !ok || err != nil || cmd == integration ||
+			cmd.Name() != entry.Name || cmd.RunE == nil {
+			return fmt.Errorf("%w: unknown integration %q",
+				integrations.ErrInvalidApplied, entry.Name)
+		}
+		item.integration, item.cmd = i, cmd
+
+		secret, err := i.Get(ctx, a.cfg)
+		if err != nil {
+			return err
+		}
+		switch {
+		case secret == nil:
+			item.action = applyCreate
+		case secret.GetAnnotations()[integrations.AppliedHashAnnotation] ==
+			item.hash:
+			item.action = applyUnchanged
+		default:
+			item.action = applyUpdate
+		}
+		a.items = append(a.items, item)
+	}
+	return nil
+}
+
+// resetFlags restores the subcommand's own flags to their defaults, the flag set
+// is shared with the subcommand instance, parsing must start clean.
+func resetFlags(cmd *cobra.Command) error {
+	var err error
+	cmd.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			err = errors.Join(err, sv.Replace(nil))
+		} else {
+			err = errors.Join(err, f.Value.Set(f.DefValue))
+		}
+		f.Changed = false
+	})
+	return err
+}
+
+// postRun runs the hooks cobra runs after RunE: the subcommand's, and the
+// nearest persistent one, the "integration" hook disabling the product which
+// provides the integration.
+func postRun(cmd *cobra.Command, args []string) error {
+	if cmd.PostRunE != nil {
+		if err := cmd.PostRunE(cmd, args); err != nil {
+			return err
+		}
+	} else if cmd.PostRun != nil {
+		cmd.PostRun(cmd, args)
+	}
+	for p := cmd; p != nil; p = p.Parent() {
+		if p.PersistentPostRunE != nil {
+			return p.PersistentPostRunE(cmd, args)
+		}
+		if p.PersistentPostRun != nil {
+			p.PersistentPostRun(cmd, args)
+			return nil
+		}
+	}
+	return nil
+}
+
+// Validate parses and validates the parameters of every integration to apply,
+// with the respective subcommand, before any secret is written.
+func (a *IntegrationApply) Validate() error {
+	ctx := a.cmd.Context()
+	for _, item := range a.items {
+		if item.action == applyUnchanged {
+			continue
+		}
+		argv := item.entry.Argv()
+		if item.action == applyUpdate {
+			argv = append([]string{"--force"}, argv...)
+		}
+		cmd := item.cmd
+		cmd.SetContext(ctx)
+		if err := resetFlags(cmd); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		if err := cmd.ParseFlags(argv); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		if err := cmd.ValidateRequiredFlags(); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		if err := cmd.ValidateFlagGroups(); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		if err := cmd.ValidateArgs(cmd.Flags().Args()); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		if cmd.PreRunE != nil {
+			if err := cmd.PreRunE(cmd, cmd.Flags().Args()); err != nil {
+				return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Run applies the new and changed integrations, recording the applied
+// parameters digest on the integration secret, and running the post-run hooks
+// as if each subcommand was invoked. On dry-run only the plan is printed.
+func (a *IntegrationApply) Run() error {
+	ctx := a.cmd.Context()
+	for _, item := range a.items {
+		fmt.Fprintf(a.runCtx.Out, "%-24s %s\n", item.entry.Name, item.action)
+	}
+	if a.runCtx.Flags.DryRun {
+		return nil
+	}
+	for _, item := range a.items {
+		if item.action == applyUnchanged {
+			continue
+		}
+		a.runCtx.Logger.Info("Applying integration",
+			"integration", item.entry.Name, "action", item.action)
+		args := item.cmd.Flags().Args()
+		if err := item.cmd.RunE(item.cmd, args); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+		// Written secrets aren't on the cluster, there's nothing to annotate.
+		if !item.integration.Writing() {
+			err := integrations.AnnotateSecret(ctx, a.runCtx.Kube, a.cfg,
+				item.integration, integrations.AppliedHashAnnotation, item.hash)
+			if err != nil {
+				return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+			}
+		}
+		if err := postRun(item.cmd, args); err != nil {
+			return fmt.Errorf("integration %q: %w", item.entry.Name, err)
+		}
+	}
+	return nil
+}
+
+// NewIntegrationApply instantiates the "integration apply" subcommand.
+func NewIntegrationApply(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationApply {
+	a := &IntegrationApply{
+		cmd: &cobra.Command{
+			Use:          "apply -f FILE",
+			Short:        "Applies the integrations declared on a file",
+			Long:         integrationApplyDesc,
+			Args:         cobra.NoArgs,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+	a.cmd.Flags().StringVarP(&a.filename, "filename", "f", a.filename,
+		`Integrations file, "-" for stdin`)
+	_ = a.cmd.MarkFlagRequired("filename")
+	return a
+}
+
+// integrationApply registers "integration apply".
+func integrationApply(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	integration, _, err := root.Find([]string{"integration"})
+	if err != nil || integration == root {
+		return
+	}
+	integration.AddCommand(api.NewRunner(NewIntegrationApply(appCtx, runCtx)).Cmd())
+}
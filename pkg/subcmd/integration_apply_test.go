@@ -0,0 +1,181 @@
+package subcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// writeApplied writes the integrations file, and the token file it references.
+func writeApplied(t *testing.T, dir, payload string) string {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, "acs-token.txt"),
+		[]byte("acs-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "integrations.yaml")
+	if err := os.WriteFile(filename, []byte(payload), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// updated returns the names of the secrets updated on the cluster.
+func (k *testKube) updated(resource string) []string {
+	names := []string{}
+	for _, a := range k.cs.Actions() {
+		update, ok := a.(clienttesting.UpdateAction)
+		if !ok || update.GetResource().Resource != resource {
+			continue
+		}
+		if obj, err := meta.Accessor(update.GetObject()); err == nil {
+			names = append(names, obj.GetName())
+		}
+	}
+	return names
+}
+
+func TestIntegrationApply(t *testing.T) {
+	t.Setenv("TSSC_TEST_JENKINS_TOKEN", "jenkins-token")
+	dir := t.TempDir()
+	applied := `
+integrations:
+  jenkins:
+    url: https://jenkins.example.com
+    username: admin
+    token: ${TSSC_TEST_JENKINS_TOKEN}
+  acs:
+    endpoint: central.example.com:443
+    token: "@acs-token.txt"
+`
+	filename := writeApplied(t, dir, applied)
+	app := newTestApp(t)
+
+	t.Run("dry-run plans", func(t *testing.T) {
+		// The global flags keep their values across runs, a fresh application.
+		fresh := newTestApp(t)
+		out, err := fresh.run(t, "integration", "apply", "-f", filename, "--dry-run")
+		if err != nil {
+			t.Fatalf("integration apply = %v\n%s", err, out)
+		}
+		for _, name := range []string{"acs", "jenkins"} {
+			if !strings.Contains(out, fmt.Sprintf("%-24s create", name)) {
+				t.Errorf("plan lacks %q create:\n%s", name, out)
+			}
+		}
+		if created := fresh.kube.created("secrets"); len(created) > 0 {
+			t.Errorf("secrets created on dry-run: %v", created)
+		}
+	})
+
+	t.Run("creates", func(t *testing.T) {
+		out, err := app.run(t, "integration", "apply", "-f", filename)
+		if err != nil {
+			t.Fatalf("integration apply = %v\n%s", err, out)
+		}
+		created := app.kube.created("secrets")
+		slices.Sort(created)
+		want := []string{"tssc-acs-integration", "tssc-jenkins-integration"}
+		if !slices.Equal(created, want) {
+			t.Fatalf("secrets created = %v, want %v", created, want)
+		}
+
+		secret, err := app.kube.cs.CoreV1().Secrets(testNamespace).Get(
+			context.TODO(), "tssc-acs-integration", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(secret.Data["token"]); got != "acs-token" {
+			t.Errorf("acs token = %q, want the file contents", got)
+		}
+		if secret.Annotations[integrations.AppliedHashAnnotation] == "" {
+			t.Error("applied hash annotation is not recorded")
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		app.kube.cs.ClearActions()
+		out, err := app.run(t, "integration", "apply", "-f", filename)
+		if err != nil {
+			t.Fatalf("integration apply = %v\n%s", err, out)
+		}
+		if !strings.Contains(out, fmt.Sprintf("%-24s unchanged", "acs")) ||
+			!strings.Contains(out, fmt.Sprintf("%-24s unchanged", "jenkins")) {
+			t.Errorf("plan lacks unchanged:\n%s", out)
+		}
+		if len(app.kube.created("secrets"))+len(app.kube.updated("secrets")) > 0 {
+			t.Error("unchanged integrations are written")
+		}
+	})
+
+	t.Run("updates the changed", func(t *testing.T) {
+		app.kube.cs.ClearActions()
+		t.Setenv("TSSC_TEST_JENKINS_TOKEN", "rotated-token")
+		out, err := app.run(t, "integration", "apply", "-f", filename)
+		if err != nil {
+			t.Fatalf("integration apply = %v\n%s", err, out)
+		}
+		if !strings.Contains(out, fmt.Sprintf("%-24s update", "jenkins")) ||
+			!strings.Contains(out, fmt.Sprintf("%-24s unchanged", "acs")) {
+			t.Errorf("plan expects jenkins updated only:\n%s", out)
+		}
+		for _, written := range [][]string{
+			app.kube.created("secrets"), app.kube.updated("secrets"),
+		} {
+			if slices.Contains(written, "tssc-acs-integration") {
+				t.Error("unchanged acs integration is written")
+			}
+		}
+		secret, err := app.kube.cs.CoreV1().Secrets(testNamespace).Get(
+			context.TODO(), "tssc-jenkins-integration", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(secret.Data["token"]); got != "rotated-token" {
+			t.Errorf("jenkins token = %q, want rotated-token", got)
+		}
+	})
+
+	t.Run("validates all before writing", func(t *testing.T) {
+		fresh := newTestApp(t)
+		invalid := writeApplied(t, t.TempDir(), `
+integrations:
+  acs:
+    endpoint: central.example.com:443
+    token: "@acs-token.txt"
+  jenkins:
+    url: https://jenkins.example.com
+`)
+		out, err := fresh.run(t, "integration", "apply", "-f", invalid)
+		if err == nil || !strings.Contains(err.Error(), `integration "jenkins"`) {
+			t.Fatalf("integration apply = %v, want jenkins invalid\n%s", err, out)
+		}
+		if created := fresh.kube.created("secrets"); len(created) > 0 {
+			t.Errorf("secrets created = %v, want none", created)
+		}
+	})
+
+	t.Run("missing environment variable", func(t *testing.T) {
+		fresh := newTestApp(t)
+		missing := writeApplied(t, t.TempDir(), `
+integrations:
+  acs:
+    endpoint: central.example.com:443
+    token: ${TSSC_TEST_MISSING}
+`)
+		_, err := fresh.run(t, "integration", "apply", "-f", missing)
+		if err == nil || !strings.Contains(err.Error(), "TSSC_TEST_MISSING") {
+			t.Fatalf("integration apply = %v, want the variable missing", err)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package subcmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// IntegrationDelete represents the "integration delete" subcommand, it removes
+// the integration secret, warning about the enabled products requiring it.
+type IntegrationDelete struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	name    string                    // integration name
+	cfg     *config.Config            // installer configuration
+	i       *api.Integration          // framework integration
+	catalog *integrations.Catalog     // charts integrations
+	secrets map[string]*corev1.Secret // configured integrations
+}
+
+var _ api.SubCommand = (*IntegrationDelete)(nil)
+
+const integrationDeleteDesc = `
+Deletes the integration secret. When charts of enabled products require the
+integration, evaluating their "integrations-required" expression without it, a
+warning is shown for each, the next deployment fails until the integration, or an
+alternative, is configured again.
+`
+
+// Cmd exposes the cobra instance.
+func (d *IntegrationDelete) Cmd() *cobra.Command {
+	return d.cmd
+}
+
+// Complete loads the charts integrations and the configured integrations.
+func (d *IntegrationDelete) Complete(args []string) error {
+	var err error
+	d.name = args[0]
+	d.cfg, d.catalog, err = loadIntegrationCatalog(d.cmd, d.appCtx, d.runCtx)
+	if err != nil {
+		return err
+	}
+	d.secrets, err = integrations.ConfiguredSecrets(
+		d.cmd.Context(), d.runCtx.Integrations, d.cfg)
+	return err
+}
+
+// Validate asserts the integration is known and configured.
+func (d *IntegrationDelete) Validate() error {
+	var err error
+	if d.i, err = integrations.Lookup(d.runCtx.Integrations, d.name); err != nil {
+		return err
+	}
+	if _, ok := d.secrets[d.name]; !ok {
+		return fmt.Errorf("integration %q is not configured", d.name)
+	}
+	return nil
+}
+
+// brokenCharts returns the charts of the topology whose required integrations are
+// met with the integration, and not without it. Integrations provided by those
+// charts are considered configured, like the deployment does.
+func (d *IntegrationDelete) brokenCharts() ([]integrations.Chart, error) {
+	configured := map[string]bool{}
+	for _, name := range d.runCtx.Integrations.IntegrationNames() {
+		_, configured[name] = d.secrets[name]
+	}
+	charts, err := d.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := topology.ResolveCharts(d.appCtx, d.cfg, charts, configured)
+	if err != nil {
+		return nil, err
+	}
+	enabled := []integrations.Chart{}
+	for _, hc := range d.catalog.Charts() {
+		if !resolved.Contains(hc.Name) {
+			continue
+		}
+		enabled = append(enabled, hc)
+		for _, p := range hc.Provided {
+			configured[p] = true
+		}
+	}
+	without := map[string]bool{}
+	for name, v := range configured {
+		without[name] = v && name != d.name
+	}
+	for _, hc := range enabled {
+		if slices.Contains(hc.Provided, d.name) {
+			without[d.name] = true
+		}
+	}
+
+	broken := []integrations.Chart{}
+	for _, hc := range enabled {
+		if hc.Required == "" {
+			continue
+		}
+		before, err := d.catalog.Satisfied(hc.Required, d.cfg, configured)
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", hc.Name, err)
+		}
+		after, err := d.catalog.Satisfied(hc.Required, d.cfg, without)
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", hc.Name, err)
+		}
+		if before && !after {
+			broken = append(broken, hc)
+		}
+	}
+	return broken, nil
+}
+
+// Run warns about the enabled charts requiring the integration, and deletes the
+// integration secret.
+func (d *IntegrationDelete) Run() error {
+	broken, err := d.brokenCharts()
+	if err != nil {
+		return err
+	}
+	for _, hc := range broken {
+		d.runCtx.Logger.Warn("Enabled product requires the integration",
+			"integration", d.name, "chart", hc.Name, "product", hc.Product,
+			"integrations-required", hc.Required)
+	}
+	if d.runCtx.Flags.DryRun {
+		d.runCtx.Logger.Info("Dry-run: skipping the integration secret deletion",
+			"integration", d.name)
+		return nil
+	}
+	if err = d.i.Delete(d.cmd.Context(), d.cfg); err != nil {
+		return err
+	}
+	d.runCtx.Logger.Info("Integration secret deleted", "integration", d.name)
+	return nil
+}
+
+// NewIntegrationDelete instantiates the "integration delete" subcommand.
+func NewIntegrationDelete(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationDelete {
+	return &IntegrationDelete{
+		cmd: &cobra.Command{
+			Use:          "delete <name>",
+			Short:        "Deletes the integration secret",
+			Long:         integrationDeleteDesc,
+			Args:         cobra.ExactArgs(1),
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+}
@@ -0,0 +1,119 @@
+package subcmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// IntegrationDescribe represents the "integration describe" subcommand, it shows
+// the integration secret without revealing the credentials.
+type IntegrationDescribe struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	name    string                // integration name
+	cfg     *config.Config        // installer configuration
+	catalog *integrations.Catalog // charts integrations
+	i       *api.Integration      // framework integration
+	secret  *corev1.Secret        // integration secret, if configured
+}
+
+var _ api.SubCommand = (*IntegrationDescribe)(nil)
+
+const integrationDescribeDesc = `
+Describes the integration: the secret keys, the values of the non-sensitive keys
+such as URLs, hosts and organizations, and the charts consuming the integration,
+either requiring or providing it. Credentials are never shown.
+`
+
+// Cmd exposes the cobra instance.
+func (d *IntegrationDescribe) Cmd() *cobra.Command {
+	return d.cmd
+}
+
+// Complete loads the charts integrations and the integration secret.
+func (d *IntegrationDescribe) Complete(args []string) error {
+	var err error
+	d.name = args[0]
+	d.cfg, d.catalog, err = loadIntegrationCatalog(d.cmd, d.appCtx, d.runCtx)
+	if err != nil {
+		return err
+	}
+	if d.i, err = integrations.Lookup(d.runCtx.Integrations, d.name); err != nil {
+		return err
+	}
+	d.secret, err = d.i.Get(d.cmd.Context(), d.cfg)
+	return err
+}
+
+// Validate asserts the integration name is known.
+func (d *IntegrationDescribe) Validate() error {
+	_, err := integrations.Lookup(d.runCtx.Integrations, d.name)
+	return err
+}
+
+// Run prints the integration description.
+func (d *IntegrationDescribe) Run() error {
+	fmt.Fprintf(d.runCtx.Out, "Name:     %s\n", d.name)
+	fmt.Fprintf(d.runCtx.Out, "Secret:   %s/%s\n",
+		d.cfg.Namespace(), d.i.SecretName())
+	if d.secret == nil {
+		fmt.Fprintf(d.runCtx.Out, "Status:   not configured\n")
+	} else {
+		fmt.Fprintf(d.runCtx.Out, "Status:   configured\n")
+		fmt.Fprintf(d.runCtx.Out, "Age:      %s\n", duration.HumanDuration(
+			time.Since(d.secret.GetCreationTimestamp().Time)))
+		fmt.Fprintf(d.runCtx.Out, "Data:\n")
+		for _, key := range slices.Sorted(maps.Keys(d.secret.Data)) {
+			value := fmt.Sprintf("<%d bytes>", len(d.secret.Data[key]))
+			if api.IsPublicIntegrationKey(key) {
+				value = string(d.secret.Data[key])
+			}
+			fmt.Fprintf(d.runCtx.Out, "  %s: %s\n", key, value)
+		}
+	}
+
+	requiredBy, err := d.catalog.RequiredBy(d.name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(d.runCtx.Out, "Required by:\n")
+	for _, hc := range requiredBy {
+		fmt.Fprintf(d.runCtx.Out, "  %s: %s\n", hc.String(), hc.Required)
+	}
+	fmt.Fprintf(d.runCtx.Out, "Provided by:\n")
+	for _, hc := range d.catalog.ProvidedBy(d.name) {
+		fmt.Fprintf(d.runCtx.Out, "  %s\n", hc.String())
+	}
+	return nil
+}
+
+// NewIntegrationDescribe instantiates the "integration describe" subcommand.
+func NewIntegrationDescribe(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationDescribe {
+	return &IntegrationDescribe{
+		cmd: &cobra.Command{
+			Use:          "describe <name>",
+			Short:        "Describes the integration",
+			Long:         integrationDescribeDesc,
+			Args:         cobra.ExactArgs(1),
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+}
@@ -0,0 +1,443 @@
+package subcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/endpoints"
+	"github.com/redhat-appstudio/tssc-cli/pkg/githubapp"
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	appmanifest "github.com/redhat-appstudio/helmet/api/githubapp"
+	"github.com/spf13/cobra"
+)
+
+// privateKeyFileFlag flag name to import an existing GitHub App.
+const privateKeyFileFlag = "private-key-file"
+
+// gitHubApp manages the GitHub App on "integration github". The framework creates
+// the application from the manifest described by the "githubApp" setting and
+// flags, or an existing application is imported, inspected with its private key
+// and stored with the same secret shape. The stored application is deleted, or
+// its secrets rotated, authenticated with its private key.
+type gitHubApp struct {
+	appCtx      *api.AppContext
+	runCtx      *runcontext.RunContext
+	integration *api.Integration // framework "github" integration
+
+	appID          int64  // github app ID
+	privateKeyFile string // github app private key file, PEM
+	clientID       string // github app client ID
+	clientSecret   string // github app client secret
+	webhookSecret  string // github app webhook secret
+
+	deleteApp    bool // uninstall the github app, deleting the secret
+	rotateSecret bool // rotate the github app secrets
+}
+
+// flags adds the import and manifest flags to the command.
+func (g *gitHubApp) flags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.Int64Var(&g.appID, "app-id", g.appID,
+		"Existing GitHub App ID to import")
+	f.StringVar(&g.privateKeyFile, privateKeyFileFlag, g.privateKeyFile,
+		"Existing GitHub App private key file (PEM)")
+	f.StringVar(&g.clientID, "client-id", g.clientID,
+		"Existing GitHub App client ID, looked up when empty")
+	f.StringVar(&g.clientSecret, "client-secret", g.clientSecret,
+		"Existing GitHub App client secret")
+	f.StringVar(&g.webhookSecret, "webhook-secret", g.webhookSecret,
+		"Existing GitHub App webhook secret")
+
+	f.BoolVar(&g.deleteApp, "delete", g.deleteApp,
+		"Uninstall the GitHub App stored on the integration secret, and delete "+
+			"the secret")
+	f.BoolVar(&g.rotateSecret, "rotate-secret", g.rotateSecret,
+		"Rotate the GitHub App webhook secret, and the client secret informed "+
+			"by --client-secret, updating the cluster")
+}
+
+// importing asserts whether the command imports an existing GitHub App.
+func (g *gitHubApp) importing(cmd *cobra.Command) bool {
+	f := cmd.Flags()
+	return f.Changed("app-id") || f.Changed(privateKeyFileFlag)
+}
+
+// creating asserts whether the command creates a new GitHub App.
+func (g *gitHubApp) creating(cmd *cobra.Command) bool {
+	create, _ := cmd.Flags().GetBool("create")
+	return create && !g.importing(cmd)
+}
+
+// managing asserts whether the command manages the stored GitHub App.
+func (g *gitHubApp) managing() bool {
+	return g.deleteApp || g.rotateSecret
+}
+
+// validateManage asserts the flags to delete, or rotate, the stored GitHub App.
+func (g *gitHubApp) validateManage(cmd *cobra.Command) error {
+	f := cmd.Flags()
+	create, _ := f.GetBool("create")
+	update, _ := f.GetBool("update")
+	if g.deleteApp && g.rotateSecret {
+		return errors.New("--delete can't be used with --rotate-secret")
+	}
+	if create || update || g.importing(cmd) {
+		return errors.New("--delete and --rotate-secret can't be used with " +
+			"--create, --update or the import flags")
+	}
+	return nil
+}
+
+// validateImport asserts the import flags are complete, the secrets can't be
+// obtained from the GitHub API.
+func (g *gitHubApp) validateImport(cmd *cobra.Command) error {
+	f := cmd.Flags()
+	create, _ := f.GetBool("create")
+	update, _ := f.GetBool("update")
+	if create || update {
+		return fmt.Errorf(
+			"--%s can't be used with --create or --update", privateKeyFileFlag)
+	}
+	if g.appID <= 0 || g.privateKeyFile == "" {
+		return fmt.Errorf("--app-id and --%s are required to import the GitHub App",
+			privateKeyFileFlag)
+	}
+	if g.clientSecret == "" || g.webhookSecret == "" {
+		return errors.New(
+			"--client-secret and --webhook-secret are required to import the GitHub App")
+	}
+	return nil
+}
+
+// manifest resolves the GitHub App manifest the framework creates the
+// application with, from the installer setting and the flags, warning when it
+// falls short of what the enabled products need.
+func (g *gitHubApp) manifest(
+	cmd *cobra.Command,
+	cfg *config.Config,
+) (*appmanifest.Manifest, error) {
+	m, err := appmanifest.ResolveManifest(cfg, cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	req := githubapp.ProductRequirements(func(name string) bool {
+		product, err := cfg.GetProduct(name)
+		return err == nil && product.Enabled
+	})
+	for _, missing := range m.Missing(&req) {
+		g.runCtx.Logger.Warn("GitHub App falls short of the enabled products",
+			"missing", missing)
+	}
+	return m, nil
+}
+
+// importApp imports the GitHub App, and stores the integration secret.
+func (g *gitHubApp) importApp(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	f := cmd.Flags()
+	logger := g.runCtx.Logger.With("integration", "github")
+
+	ic, err := integrationContext(ctx, g.appCtx, g.runCtx)
+	if err != nil {
+		return err
+	}
+	m, err := g.manifest(cmd, ic.GetConfig())
+	if err != nil {
+		return err
+	}
+	pem, err := os.ReadFile(g.privateKeyFile)
+	if err != nil {
+		return err
+	}
+	gitHubURL, _ := f.GetString("github-url")
+	token, _ := f.GetString("token")
+	data, err := githubapp.NewImporter(logger, gitHubURL).Import(ctx,
+		&githubapp.Credentials{
+			AppID:         g.appID,
+			PrivateKey:    pem,
+			ClientID:      g.clientID,
+			ClientSecret:  g.clientSecret,
+			WebhookSecret: g.webhookSecret,
+		}, &m.Requirements, token)
+	if err != nil {
+		return err
+	}
+	if org, _ := f.GetString("org"); org != "" && org != string(data["ownerLogin"]) {
+		logger.Warn("GitHub App owner differs from the informed organization",
+			"org", org, "owner", string(data["ownerLogin"]))
+	}
+
+	if g.runCtx.Flags.DryRun {
+		logger.Info("Dry-run mode, skipping the integration secret",
+			"slug", string(data["slug"]))
+		return nil
+	}
+	return g.integration.Store(ctx, ic.GetConfig(), data)
+}
+
+// urls resolves the GitHub App URLs from the flags, and the ones not informed
+// from the Developer Hub and Pipelines-as-Code endpoints.
+func (g *gitHubApp) urls(
+	cmd *cobra.Command,
+	ic api.IntegrationContext,
+) (*endpoints.URLs, error) {
+	f := cmd.Flags()
+	urls := &endpoints.URLs{}
+	urls.Callback, _ = f.GetString("callback-url")
+	urls.Homepage, _ = f.GetString("homepage-url")
+	urls.Webhook, _ = f.GetString("webhook-url")
+	if urls.Callback != "" && urls.Homepage != "" && urls.Webhook != "" {
+		return urls, nil
+	}
+
+	resolved, err := endpoints.AuthProviderURLs("github")(cmd.Context(), ic)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"provide --callback-url, --homepage-url and --webhook-url: %w", err)
+	}
+	if urls.Callback == "" {
+		urls.Callback = resolved.Callback
+	}
+	if urls.Homepage == "" {
+		urls.Homepage = resolved.Homepage
+	}
+	if urls.Webhook == "" {
+		urls.Webhook = resolved.Webhook
+	}
+	return urls, nil
+}
+
+// showManifest shows the GitHub App manifest the framework creates the
+// application with, in dry-run mode.
+func (g *gitHubApp) showManifest(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	ic, err := integrationContext(ctx, g.appCtx, g.runCtx)
+	if err != nil {
+		return err
+	}
+	m, err := g.manifest(cmd, ic.GetConfig())
+	if err != nil {
+		return err
+	}
+	urls, err := g.urls(cmd, ic)
+	if err != nil {
+		return err
+	}
+	description, _ := cmd.Flags().GetString("description")
+	manifest, err := m.AppManifest(
+		args[0], description, urls.Homepage, urls.Callback, urls.Webhook)
+	if err != nil {
+		return err
+	}
+	g.runCtx.Logger.Info("Dry-run mode, showing the GitHub App manifest",
+		"integration", "github", "app-name", args[0])
+	enc := json.NewEncoder(g.runCtx.Out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(manifest)
+}
+
+// warnManifest warns when the manifest the framework creates the GitHub App
+// with falls short of the enabled products.
+func (g *gitHubApp) warnManifest(cmd *cobra.Command) error {
+	ic, err := integrationContext(cmd.Context(), g.appCtx, g.runCtx)
+	if err != nil {
+		return err
+	}
+	_, err = g.manifest(cmd, ic.GetConfig())
+	return err
+}
+
+// stored loads the GitHub App manager for the application stored on the
+// integration secret, and the secret data.
+func (g *gitHubApp) stored(cmd *cobra.Command) (
+	*githubapp.Manager,
+	*config.Config,
+	map[string][]byte,
+	error,
+) {
+	ctx := cmd.Context()
+	cfg, err := config.NewConfigMapManager(
+		g.runCtx.Kube, g.appCtx.Name, g.runCtx.Flags.Instance).
+		GetConfig(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	secret, err := g.integration.Get(ctx, cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if secret == nil {
+		return nil, nil, nil, fmt.Errorf("%w: %s/%s", api.ErrSecretNotFound,
+			cfg.Namespace(), g.integration.SecretName())
+	}
+	creds, err := githubapp.CredentialsFromSecret(secret.Data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	gitHubURL := githubapp.GitHubURL(string(secret.Data["host"]))
+	if cmd.Flags().Changed("github-url") {
+		gitHubURL, _ = cmd.Flags().GetString("github-url")
+	}
+	m, err := githubapp.NewManager(g.runCtx.Logger, gitHubURL, creds)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return m, cfg, secret.Data, nil
+}
+
+// settingsURL returns the stored GitHub App settings page, the HTML URL is used
+// when the app can't be retrieved.
+func (g *gitHubApp) settingsURL(
+	cmd *cobra.Command,
+	m *githubapp.Manager,
+	data map[string][]byte,
+) string {
+	app, err := m.App(cmd.Context())
+	if err != nil {
+		g.runCtx.Logger.Warn("Unable to retrieve the GitHub App", "error", err)
+		return string(data["htmlURL"])
+	}
+	return githubapp.SettingsURL(githubapp.GitHubURL(string(data["host"])), app)
+}
+
+// delete uninstalls the stored GitHub App and deletes the integration secret.
+// The app registration is deleted on GitHub's web interface.
+func (g *gitHubApp) delete(cmd *cobra.Command) error {
+	m, cfg, data, err := g.stored(cmd)
+	if err != nil {
+		return err
+	}
+	logger := g.runCtx.Logger.With("integration", "github",
+		"slug", string(data["slug"]))
+	if g.runCtx.Flags.DryRun {
+		logger.Info("Dry-run mode, skipping the GitHub App deletion")
+		return nil
+	}
+	settingsURL := g.settingsURL(cmd, m, data)
+	n, err := m.Uninstall(cmd.Context())
+	if err != nil {
+		return err
+	}
+	logger.Info("GitHub App uninstalled", "installations", n)
+	if err = g.integration.Delete(cmd.Context(), cfg); err != nil {
+		return err
+	}
+	logger.Info("Integration secret is deleted successfully!")
+	fmt.Fprintf(g.runCtx.Out, "# GitHub App %q is uninstalled, GitHub's API doesn't delete "+
+		"the app registration, delete it on:\n#   %s/advanced\n",
+		string(data["slug"]), settingsURL)
+	return nil
+}
+
+// rotate rotates the stored GitHub App webhook secret, and the client secret
+// informed, updating the integration secret and its consumers on the cluster.
+func (g *gitHubApp) rotate(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	m, cfg, data, err := g.stored(cmd)
+	if err != nil {
+		return err
+	}
+	logger := g.runCtx.Logger.With("integration", "github",
+		"slug", string(data["slug"]))
+	if g.runCtx.Flags.DryRun {
+		logger.Info("Dry-run mode, skipping the GitHub App secrets rotation")
+		return nil
+	}
+
+	rotated := map[string][]byte{}
+	if cmd.Flags().Changed("client-secret") {
+		rotated["clientSecret"] = []byte(g.clientSecret)
+	} else {
+		logger.Warn("Client secret is kept, GitHub's API doesn't issue client "+
+			"secrets; generate a new one on the app settings and inform "+
+			"--client-secret", "settings-url", g.settingsURL(cmd, m, data))
+	}
+	webhookSecret, err := m.RotateWebhookSecret(ctx)
+	if err != nil {
+		return err
+	}
+	rotated["webhookSecret"] = []byte(webhookSecret)
+
+	if err = g.integration.Update(ctx, cfg, rotated); err != nil {
+		return err
+	}
+	if g.integration.Writing() {
+		logger.Info("GitHub App secrets rotated, the consumers are updated " +
+			"once the integration secret manifest is applied and deployed")
+		return nil
+	}
+	n, err := integrations.SyncConsumers(ctx, logger, g.runCtx.Kube, cfg,
+		integrations.GitHubConsumers, rotated)
+	if err != nil {
+		return err
+	}
+	logger.Info("GitHub App secrets rotated", "consumers", n)
+	return nil
+}
+
+// integrationGitHub extends "integration github" to import an existing GitHub
+// App, to delete or rotate the secrets of the stored GitHub App, and to show the
+// manifest the framework creates the GitHub App with on dry-run.
+func integrationGitHub(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	cmd, _, err := root.Find([]string{"integration", "github"})
+	if err != nil || cmd.Name() != "github" {
+		return
+	}
+	i, ok := runCtx.Integrations.Lookup("github")
+	if !ok {
+		return
+	}
+	g := &gitHubApp{appCtx: appCtx, runCtx: runCtx, integration: i}
+	g.flags(cmd)
+	// The organization is only required to create the GitHub App, the owner of
+	// an imported application is informed by the API.
+	_ = cmd.PersistentFlags().SetAnnotation(
+		"org", cobra.BashCompOneRequiredFlag, []string{"false"})
+
+	preRunE, runE := cmd.PreRunE, cmd.RunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if g.managing() {
+			return g.validateManage(c)
+		}
+		if g.importing(c) {
+			return g.validateImport(c)
+		}
+		if !c.Flags().Changed("org") {
+			return errors.New(`required flag(s) "org" not set`)
+		}
+		if preRunE != nil {
+			return preRunE(c, args)
+		}
+		return nil
+	}
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		switch {
+		case g.deleteApp:
+			return g.delete(c)
+		case g.rotateSecret:
+			return g.rotate(c)
+		case g.importing(c):
+			return g.importApp(c)
+		case g.creating(c) && runCtx.Flags.DryRun:
+			return g.showManifest(c, args)
+		case g.creating(c):
+			if err := g.warnManifest(c); err != nil {
+				return err
+			}
+		}
+		if runE != nil {
+			return runE(c, args)
+		}
+		return nil
+	}
+}
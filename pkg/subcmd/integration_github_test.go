@@ -0,0 +1,115 @@
+package subcmd
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// gitHubAppJSON the GitHub App the fake API serves, granting what the "full"
+// preset requires.
+const gitHubAppJSON = `{
+  "id": 123,
+  "slug": "tssc-app",
+  "client_id": "Iv1.client",
+  "html_url": "https://github.example.com/apps/tssc-app",
+  "owner": {"login": "tssc", "id": 1},
+  "permissions": {
+    "administration": "write", "checks": "write", "contents": "write",
+    "issues": "write", "members": "read", "metadata": "read",
+    "organization_plan": "read", "pull_requests": "write", "workflows": "write"
+  },
+  "events": [
+    "check_run", "check_suite", "commit_comment", "issue_comment",
+    "pull_request", "push"
+  ]
+}`
+
+// writePEM writes the PEM block on a temporary file, returning its path.
+func writePEM(t *testing.T, name, blockType string, payload []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, pem.EncodeToMemory(
+		&pem.Block{Type: blockType, Bytes: payload}), 0o600)
+	if err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	return path
+}
+
+func TestIntegrationGitHub_SecretOutput(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating the key: %v", err)
+	}
+	keyFile := writePEM(t, "app.pem", "RSA PRIVATE KEY",
+		x509.MarshalPKCS1PrivateKey(key))
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sealed-secrets"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(
+		rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating the certificate: %v", err)
+	}
+	certFile := writePEM(t, "cert.pem", "CERTIFICATE", cert)
+
+	api := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v3/app" ||
+				!strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(gitHubAppJSON))
+		}))
+	t.Cleanup(api.Close)
+
+	app := newTestApp(t)
+	manifest := filepath.Join(t.TempDir(), "github.yaml")
+	out, err := app.run(t, "integration", "github",
+		"--github-url", api.URL,
+		"--app-id", "123",
+		"--private-key-file", keyFile,
+		"--client-secret", "client-secret",
+		"--webhook-secret", "webhook-secret",
+		"--secret-output", "sealed-secret",
+		"--sealed-secret-cert", certFile,
+		"--secret-output-file", manifest,
+	)
+	if err != nil {
+		t.Fatalf("integration github: %v\n%s", err, out)
+	}
+
+	if created := app.kube.created("secrets"); len(created) > 0 {
+		t.Errorf("secrets applied on the cluster: %v", created)
+	}
+	payload, err := os.ReadFile(manifest)
+	if err != nil {
+		t.Fatalf("reading the manifest: %v", err)
+	}
+	for _, want := range []string{"kind: SealedSecret", "name: tssc-github-integration"} {
+		if !strings.Contains(string(payload), want) {
+			t.Errorf("manifest lacks %q:\n%s", want, payload)
+		}
+	}
+	for _, secret := range []string{"client-secret", "webhook-secret", "BEGIN RSA"} {
+		if strings.Contains(string(payload), secret) {
+			t.Errorf("manifest reveals %q:\n%s", secret, payload)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/endpoints"
+	"github.com/redhat-appstudio/tssc-cli/pkg/gitlabapp"
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/spf13/cobra"
+)
+
+// createAppFlag flag name to create the GitLab OAuth application.
+const createAppFlag = "create-app"
+
+// gitLabApp provisions the GitLab OAuth application for the "integration
+// gitlab" subcommand, the resulting credentials are informed to the framework
+// integration as "--app-id" and "--app-secret".
+type gitLabApp struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+}
+
+// baseURL returns the GitLab URL from the integration flags.
+func (g *gitLabApp) baseURL(cmd *cobra.Command) (string, error) {
+	host, err := cmd.Flags().GetString("host")
+	if err != nil {
+		return "", err
+	}
+	port, err := cmd.Flags().GetInt("port")
+	if err != nil {
+		return "", err
+	}
+	if port != 443 {
+		return fmt.Sprintf("https://%s:%d", host, port), nil
+	}
+	return fmt.Sprintf("https://%s", host), nil
+}
+
+// provision creates, or reuses, the OAuth application. An existing integration
+// secret is only replaced with "--force", in which case the application secret
+// is rotated; otherwise it's an error, before reaching GitLab.
+func (g *gitLabApp) provision(cmd *cobra.Command) error {
+	flags := cmd.Flags()
+	if flags.Changed("app-id") || flags.Changed("app-secret") {
+		return fmt.Errorf(
+			"--%s can't be used with --app-id and --app-secret", createAppFlag)
+	}
+	logger := g.runCtx.Logger.With("integration", "gitlab")
+	if g.runCtx.Flags.DryRun {
+		logger.Info("Dry-run mode, skipping the GitLab OAuth application")
+		return nil
+	}
+
+	ctx := cmd.Context()
+	ic, err := integrationContext(ctx, g.appCtx, g.runCtx)
+	if err != nil {
+		return err
+	}
+	force, _ := flags.GetBool("force")
+	i, err := integrations.Lookup(g.runCtx.Integrations, "gitlab")
+	if err != nil {
+		return err
+	}
+	secret, err := i.Get(ctx, ic.GetConfig())
+	if err != nil {
+		return err
+	}
+	if secret != nil && !force {
+		return fmt.Errorf("%w: %s/%s, use --force to rotate the GitLab OAuth "+
+			"application secret and replace it",
+			api.ErrSecretAlreadyExists, secret.GetNamespace(), secret.GetName())
+	}
+
+	urls, err := endpoints.AuthProviderURLs("gitlab")(ctx, ic)
+	if err != nil {
+		return fmt.Errorf("resolving the Developer Hub callback URL: %w", err)
+	}
+	baseURL, err := g.baseURL(cmd)
+	if err != nil {
+		return err
+	}
+	token, _ := flags.GetString("token")
+	insecure, _ := flags.GetBool("insecure")
+	group, _ := flags.GetString("group")
+	if token == "" || group == "" {
+		return errors.New("--token and --group are required to create the application")
+	}
+	client, err := gitlabapp.NewClient(baseURL, token, insecure)
+	if err != nil {
+		return err
+	}
+	creds, err := gitlabapp.NewOAuthApp(logger, client).
+		Ensure(gitlabapp.Name(g.appCtx.Name, group), urls.Callback)
+	if err != nil {
+		return fmt.Errorf("provisioning the GitLab OAuth application: %w", err)
+	}
+	if err = flags.Set("app-id", creds.ClientID); err != nil {
+		return err
+	}
+	return flags.Set("app-secret", creds.ClientSecret)
+}
+
+// integrationGitLabApp adds the "--create-app" flag to "integration gitlab",
+// provisioning the OAuth application before the framework's pre-run.
+func integrationGitLabApp(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	cmd, _, err := root.Find([]string{"integration", "gitlab"})
+	if err != nil || cmd.Name() != "gitlab" {
+		return
+	}
+	g := &gitLabApp{appCtx: appCtx, runCtx: runCtx}
+	var createApp bool
+	cmd.Flags().BoolVar(&createApp, createAppFlag, false,
+		"Create the GitLab OAuth application for Developer Hub, reusing an "+
+			"existing one; with --force its secret is rotated")
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if createApp {
+			if err := g.provision(c); err != nil {
+				return err
+			}
+		}
+		if preRunE != nil {
+			return preRunE(c, args)
+		}
+		return nil
+	}
+}
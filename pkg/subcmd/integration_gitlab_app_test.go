@@ -0,0 +1,49 @@
+package subcmd
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api"
+)
+
+func TestIntegrationGitLabApp(t *testing.T) {
+	t.Run("configured integration requires force", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewTLSServer(http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusForbidden)
+			}))
+		defer server.Close()
+		u, err := url.Parse(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		app := newTestApp(t, integrationSecret("gitlab", map[string]string{
+			"host": u.Hostname(), "clientId": "client-id",
+		}))
+		out, err := app.run(t, "integration", "gitlab", "--create-app",
+			"--host", u.Hostname(), "--port", u.Port(), "--insecure",
+			"--group", "platform", "--token", "token")
+		if !errors.Is(err, api.ErrSecretAlreadyExists) {
+			t.Fatalf("integration gitlab = %v, want %v\n%s",
+				err, api.ErrSecretAlreadyExists, out)
+		}
+		// Refused by the provisioning, not the framework's pre-run.
+		if !strings.Contains(err.Error(), "GitLab OAuth application") {
+			t.Errorf("integration gitlab = %v, want the OAuth application", err)
+		}
+		if requests != 0 {
+			t.Errorf("GitLab requests = %d, want none", requests)
+		}
+		if got := app.kube.updated("secrets"); len(got) > 0 {
+			t.Errorf("secrets updated: %v", got)
+		}
+	})
+}
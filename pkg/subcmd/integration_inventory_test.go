@@ -0,0 +1,198 @@
+package subcmd
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// integrationSecret the integration secret on the installer namespace, created
+// ten days ago.
+func integrationSecret(name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "tssc-" + name + "-integration",
+			CreationTimestamp: metav1.NewTime(
+				time.Now().Add(-10 * 24 * time.Hour)),
+		},
+		Data: map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+// acsSecret the ACS integration secret, the endpoint is a public key.
+var acsSecret = integrationSecret("acs", map[string]string{
+	"endpoint": "central.example.com:443",
+	"token":    "s3cr3t",
+})
+
+func TestIntegrationDescribe(t *testing.T) {
+	tests := []struct {
+		name string // integration name
+		want string // output expected
+	}{{
+		name: "acs",
+		want: `Name:     acs
+Secret:   tssc/tssc-acs-integration
+Status:   configured
+Age:      10d
+Data:
+  endpoint: central.example.com:443
+  token: <6 bytes>
+Required by:
+  tssc-app-namespaces: (bitbucket || github || gitlab) && acs && trustification && trustificationauth
+Provided by:
+  tssc-acs (Advanced Cluster Security)
+`,
+	}, {
+		name: "quay",
+		want: `Name:     quay
+Secret:   tssc/tssc-quay-integration
+Status:   not configured
+Required by:
+  tssc-dh (Developer Hub): (bitbucket || github || gitlab) && (artifactory || nexus || quay)
+Provided by:
+`,
+	}}
+	app := newTestApp(t, acsSecret.DeepCopy())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := app.run(t, "integration", "describe", tt.name)
+			if err != nil {
+				t.Fatalf("integration describe = %v\n%s", err, out)
+			}
+			if out != tt.want {
+				t.Errorf("integration describe =\n%s\nwant:\n%s", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		if _, err := app.run(t, "integration", "describe", "unknown"); err == nil {
+			t.Error("integration describe unknown: error expected")
+		}
+	})
+}
+
+func TestIntegrationList(t *testing.T) {
+	app := newTestApp(t, acsSecret.DeepCopy())
+	out, err := app.run(t, "integration", "list")
+	if err != nil {
+		t.Fatalf("integration list = %v\n%s", err, out)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if got := strings.Fields(lines[0]); !slices.Equal(got, []string{
+		"NAME", "STATUS", "AGE", "REQUIRED", "BY", "PROVIDED", "BY",
+	}) {
+		t.Errorf("header = %q", lines[0])
+	}
+	rows := map[string]string{}
+	for _, line := range lines[1:] {
+		rows[strings.Fields(line)[0]] = strings.Join(strings.Fields(line), " ")
+	}
+	for name, want := range map[string]string{
+		"acs": "acs configured 10d tssc-app-namespaces " +
+			"tssc-acs (Advanced Cluster Security)",
+		"quay":    "quay not configured - tssc-dh (Developer Hub) -",
+		"jenkins": "jenkins not configured - - -",
+		"tas": "tas not configured - tssc-pipelines (OpenShift Pipelines) " +
+			"tssc-tas (Trusted Artifact Signer)",
+	} {
+		if rows[name] != want {
+			t.Errorf("row %q = %q, want %q", name, rows[name], want)
+		}
+	}
+	if len(rows) != len(app.runCtx.Integrations.IntegrationNames()) {
+		t.Errorf("rows %d, want one per integration", len(rows))
+	}
+}
+
+func TestIntegrationDelete(t *testing.T) {
+	secretGone := func(t *testing.T, app *testApp, name string) bool {
+		t.Helper()
+		_, err := app.kube.cs.CoreV1().Secrets(testNamespace).Get(
+			context.TODO(), name, metav1.GetOptions{})
+		return apierrors.IsNotFound(err)
+	}
+
+	t.Run("deletes", func(t *testing.T) {
+		app := newTestApp(t, acsSecret.DeepCopy())
+		if out, err := app.run(t, "integration", "delete", "acs"); err != nil {
+			t.Fatalf("integration delete = %v\n%s", err, out)
+		}
+		if !secretGone(t, app, "tssc-acs-integration") {
+			t.Error("integration secret is not deleted")
+		}
+	})
+
+	t.Run("dry-run", func(t *testing.T) {
+		app := newTestApp(t, acsSecret.DeepCopy())
+		out, err := app.run(t, "integration", "delete", "acs", "--dry-run")
+		if err != nil {
+			t.Fatalf("integration delete = %v\n%s", err, out)
+		}
+		if secretGone(t, app, "tssc-acs-integration") {
+			t.Error("integration secret is deleted on dry-run")
+		}
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		app := newTestApp(t)
+		_, err := app.run(t, "integration", "delete", "quay")
+		if err == nil || !strings.Contains(err.Error(), "is not configured") {
+			t.Errorf("integration delete = %v, want not configured", err)
+		}
+	})
+
+	t.Run("warns about the charts requiring it", func(t *testing.T) {
+		tests := []struct {
+			name   string   // integration deleted
+			broken []string // charts expected to break
+		}{
+			{name: "quay", broken: []string{"tssc-dh"}},
+			// Still met by "tssc-tas", which provides it.
+			{name: "tas", broken: []string{}},
+			{name: "jenkins", broken: []string{}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				app := newTestApp(t,
+					integrationSecret("github", map[string]string{"id": "1"}),
+					integrationSecret("quay", map[string]string{"url": "q"}),
+					integrationSecret("tas", map[string]string{"rekor_url": "r"}),
+					integrationSecret("jenkins", map[string]string{"url": "j"}),
+				)
+				d := NewIntegrationDelete(app.appCtx, app.runCtx)
+				d.Cmd().SetContext(context.TODO())
+				if err := d.Complete([]string{tt.name}); err != nil {
+					t.Fatal(err)
+				}
+				if err := d.Validate(); err != nil {
+					t.Fatal(err)
+				}
+				broken, err := d.brokenCharts()
+				if err != nil {
+					t.Fatalf("brokenCharts() = %v", err)
+				}
+				names := []string{}
+				for _, hc := range broken {
+					names = append(names, hc.Name)
+				}
+				if !slices.Equal(names, tt.broken) {
+					t.Errorf("brokenCharts() = %v, want %v", names, tt.broken)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package subcmd
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// IntegrationList represents the "integration list" subcommand, it shows the
+// integrations status and the charts providing or requiring them.
+type IntegrationList struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	cfg     *config.Config            // installer configuration
+	catalog *integrations.Catalog     // charts integrations
+	secrets map[string]*corev1.Secret // configured integrations
+}
+
+var _ api.SubCommand = (*IntegrationList)(nil)
+
+const integrationListDesc = `
+Lists the integrations, showing whether each is configured in the cluster, the
+integration secret age, and which charts, and their products, require or provide
+the integration.
+`
+
+// Cmd exposes the cobra instance.
+func (l *IntegrationList) Cmd() *cobra.Command {
+	return l.cmd
+}
+
+// Complete loads the cluster configuration, the charts integrations and the
+// configured integration secrets.
+func (l *IntegrationList) Complete(_ []string) error {
+	var err error
+	l.cfg, l.catalog, err = loadIntegrationCatalog(l.cmd, l.appCtx, l.runCtx)
+	if err != nil {
+		return err
+	}
+	l.secrets, err = integrations.ConfiguredSecrets(
+		l.cmd.Context(), l.runCtx.Integrations, l.cfg)
+	return err
+}
+
+// Validate is a no-op.
+func (l *IntegrationList) Validate() error {
+	return nil
+}
+
+// Run prints the integrations table.
+func (l *IntegrationList) Run() error {
+	w := tabwriter.NewWriter(l.runCtx.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tAGE\tREQUIRED BY\tPROVIDED BY")
+	for _, name := range l.runCtx.Integrations.IntegrationNames() {
+		status, age := "not configured", "-"
+		if secret, ok := l.secrets[name]; ok {
+			status = "configured"
+			age = duration.HumanDuration(
+				time.Since(secret.GetCreationTimestamp().Time))
+		}
+		requiredBy, err := l.catalog.RequiredBy(name)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", name, status, age,
+			chartsColumn(requiredBy), chartsColumn(l.catalog.ProvidedBy(name)))
+	}
+	return w.Flush()
+}
+
+// chartsColumn returns the charts as a table column.
+func chartsColumn(charts []integrations.Chart) string {
+	if len(charts) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(charts))
+	for _, hc := range charts {
+		names = append(names, hc.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+// loadIntegrationCatalog loads the cluster configuration and inspects the
+// charts integrations annotations.
+func loadIntegrationCatalog(
+	cmd *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) (*config.Config, *integrations.Catalog, error) {
+	cfg, err := config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance).
+		GetConfig(cmd.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	charts, err := runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return nil, nil, err
+	}
+	catalog, err := integrations.NewCatalog(
+		charts, runCtx.Integrations.IntegrationNames())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, catalog, nil
+}
+
+// NewIntegrationList instantiates the "integration list" subcommand.
+func NewIntegrationList(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationList {
+	return &IntegrationList{
+		cmd: &cobra.Command{
+			Use:          "list",
+			Short:        "Lists the integrations and their status",
+			Long:         integrationListDesc,
+			Args:         cobra.NoArgs,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+}
+
+// integrationInventory registers "integration list", "describe", "delete" and
+// "requirements".
+func integrationInventory(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	integration, _, err := root.Find([]string{"integration"})
+	if err != nil || integration == root {
+		return
+	}
+	integration.AddCommand(
+		api.NewRunner(NewIntegrationList(appCtx, runCtx)).Cmd(),
+		api.NewRunner(NewIntegrationDescribe(appCtx, runCtx)).Cmd(),
+		api.NewRunner(NewIntegrationDelete(appCtx, runCtx)).Cmd(),
+		api.NewRunner(NewIntegrationRequirements(appCtx, runCtx)).Cmd(),
+	)
+}
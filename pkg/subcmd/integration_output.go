@@ -0,0 +1,159 @@
+package subcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/secretoutput"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// secretOutputFlag flag name for the integration secret output format.
+const secretOutputFlag = "secret-output"
+
+// secretOutput writes the integration secret as a GitOps manifest, instead of
+// storing it in the cluster. The framework builds the integration secret from the
+// integration module data, and hands it over to the SecretWriter.
+type secretOutput struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+	name   string // integration name
+
+	format   string               // output format
+	file     string               // output file, "-" for stdout
+	certPath string               // SealedSecret certificate file
+	scope    string               // SealedSecret scope
+	options  secretoutput.Options // output options
+}
+
+// addFlags registers the output flags on the integration subcommand.
+func (o *secretOutput) addFlags(cmd *cobra.Command) {
+	p := cmd.Flags()
+	p.StringVar(&o.format, secretOutputFlag, "", fmt.Sprintf(
+		"Write the integration secret as a manifest instead of storing it, "+
+			"one of %v", secretoutput.Formats))
+	p.StringVar(&o.file, "secret-output-file", "-",
+		`Secret output manifest file, "-" for stdout`)
+	p.StringVar(&o.certPath, "sealed-secret-cert", "",
+		"SealedSecret controller certificate, PEM file (kubeseal --fetch-cert)")
+	p.StringVar(&o.scope, "sealed-secret-scope", string(secretoutput.ScopeStrict),
+		fmt.Sprintf("SealedSecret scope, one of %v", secretoutput.Scopes))
+	p.StringVar(&o.options.SecretStore, "secret-store", "",
+		"ExternalSecret store name")
+	p.StringVar(&o.options.SecretStoreKind, "secret-store-kind", "SecretStore",
+		"ExternalSecret store kind, SecretStore or ClusterSecretStore")
+	p.StringVar(&o.options.SecretStorePath, "secret-store-path",
+		fmt.Sprintf("%s/%s", o.appCtx.Name, o.name),
+		"ExternalSecret remote key, the integration secret keys are its properties")
+	p.StringArrayVar(&o.options.SOPSArgs, "sops-arg", nil,
+		`Additional "sops encrypt" argument, e.g. "--age=age1..."`)
+}
+
+// complete validates the output options, before the integration subcommand runs.
+func (o *secretOutput) complete() error {
+	o.options.Format = secretoutput.Format(o.format)
+	o.options.SealedSecretScope = secretoutput.Scope(o.scope)
+	if o.certPath != "" {
+		var err error
+		if o.options.SealedSecretCert, err = os.ReadFile(o.certPath); err != nil {
+			return err
+		}
+	}
+	if o.file != "-" {
+		o.options.SOPSPath = o.file
+	}
+	return o.options.Validate()
+}
+
+// write renders the integration secret on the output.
+func (o *secretOutput) write(secret *corev1.Secret) error {
+	manifest, err := secretoutput.Render(secret, &o.options)
+	if err != nil {
+		return err
+	}
+	// The manifest is safe by construction, written unmasked, masking would
+	// corrupt it.
+	if o.file == "-" {
+		_, err = os.Stdout.Write(manifest)
+		return err
+	}
+	if err = os.WriteFile(o.file, manifest, 0o600); err != nil {
+		return err
+	}
+	o.runCtx.Logger.Info("Integration secret manifest written",
+		"integration", o.name, "format", o.format, "file", o.file)
+	return nil
+}
+
+// SecretWriter writes the integration secrets as manifests, for the integration
+// subcommands informed with "--secret-output", the framework stores the others
+// in the cluster as usual.
+type SecretWriter struct {
+	outputs map[string]*secretOutput // outputs by integration secret name
+}
+
+var _ api.SecretWriter = (*SecretWriter)(nil)
+
+// Enabled whether the "--secret-output" is informed for the integration secret.
+func (s *SecretWriter) Enabled(name string) bool {
+	o, ok := s.outputs[name]
+	return ok && o.format != ""
+}
+
+// Write writes the integration secret manifest.
+func (s *SecretWriter) Write(_ context.Context, secret *corev1.Secret) error {
+	o, ok := s.outputs[secret.GetName()]
+	if !ok {
+		return fmt.Errorf("no secret output for %q", secret.GetName())
+	}
+	return o.write(secret)
+}
+
+// NewSecretWriter instantiates the integration secrets writer, the integration
+// subcommands register their outputs on AddCommands.
+func NewSecretWriter() *SecretWriter {
+	return &SecretWriter{outputs: map[string]*secretOutput{}}
+}
+
+// integrationOutput adds "--secret-output" to the integration subcommands, to
+// write the integration secret as a SealedSecret, an ExternalSecret or a SOPS
+// encrypted manifest, for GitOps managed clusters.
+func integrationOutput(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	writer *SecretWriter,
+) {
+	integration, _, err := root.Find([]string{"integration"})
+	if err != nil || integration == root {
+		return
+	}
+	for _, name := range runCtx.Integrations.IntegrationNames() {
+		i, _ := runCtx.Integrations.Lookup(name)
+		cmd, _, err := integration.Find([]string{name})
+		if err != nil || cmd.Name() != name || cmd.RunE == nil {
+			continue
+		}
+		o := &secretOutput{appCtx: appCtx, runCtx: runCtx, name: name}
+		o.addFlags(cmd)
+		writer.outputs[i.SecretName()] = o
+
+		preRunE := cmd.PreRunE
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			if o.format != "" {
+				if err := o.complete(); err != nil {
+					return err
+				}
+			}
+			if preRunE != nil {
+				return preRunE(c, args)
+			}
+			return nil
+		}
+	}
+}
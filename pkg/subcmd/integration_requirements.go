@@ -0,0 +1,165 @@
+package subcmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"github.com/spf13/cobra"
+)
+
+// IntegrationRequirements represents the "integration requirements" subcommand,
+// it shows the integrations to configure before deploying the current
+// configuration.
+type IntegrationRequirements struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	requirements *resolver.Requirements // topology requirements
+}
+
+var _ api.SubCommand = (*IntegrationRequirements)(nil)
+
+const integrationRequirementsDesc = `
+Shows the integrations to configure before deploying the current configuration.
+
+The "integrations-required" expressions of the charts in the topology are
+collected, the integrations provided by those charts are considered configured,
+as the deployment does. The minimal sets of integrations satisfying every chart
+are searched, and summarized as groups: configuring one integration of each group
+satisfies the requirements, for instance one of "bitbucket", "github" or
+"gitlab", and one of "artifactory", "nexus" or "quay".
+
+The groups without an integration configured are marked as missing, those are
+the integrations the "deploy" subcommand reports as missing.
+`
+
+// Cmd exposes the cobra instance.
+func (r *IntegrationRequirements) Cmd() *cobra.Command {
+	return r.cmd
+}
+
+// Complete loads the cluster configuration, resolves the topology and computes
+// the integrations it requires.
+func (r *IntegrationRequirements) Complete(_ []string) error {
+	ctx := r.cmd.Context()
+	cfg, err := config.NewConfigMapManager(
+		r.runCtx.Kube, r.appCtx.Name, r.runCtx.Flags.Instance).
+		GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	configured, err := integrations.Configured(
+		ctx, r.runCtx.Integrations, cfg)
+	if err != nil {
+		return err
+	}
+	charts, err := r.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	resolved, err := topology.ResolveCharts(r.appCtx, cfg, charts, configured)
+	if err != nil {
+		return err
+	}
+	r.requirements, err = topology.Requirements(cfg, resolved, configured)
+	return err
+}
+
+// Validate is a no-op.
+func (r *IntegrationRequirements) Validate() error {
+	return nil
+}
+
+// Run prints the charts requirements, the groups of integrations to configure
+// and the minimal sets.
+func (r *IntegrationRequirements) Run() error {
+	req := r.requirements
+	if len(req.Dependencies) == 0 {
+		fmt.Fprintln(r.runCtx.Out, "No chart in the topology requires integrations.")
+		return nil
+	}
+	w := tabwriter.NewWriter(r.runCtx.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHART\tINTEGRATIONS REQUIRED")
+	for _, d := range req.Dependencies {
+		name := d.Name()
+		if product := d.ProductName(); product != "" {
+			name = fmt.Sprintf("%s (%s)", name, product)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", name, d.IntegrationsRequired())
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(req.Provided) > 0 {
+		fmt.Fprintf(r.runCtx.Out, "\nProvided by the charts: %s\n",
+			strings.Join(req.Provided, ", "))
+	}
+	if len(req.Groups) == 0 {
+		fmt.Fprintln(r.runCtx.Out, "\nNo integration to configure, the charts provide them.")
+		return nil
+	}
+
+	fmt.Fprintln(r.runCtx.Out, "\nConfigure one integration of each group:")
+	w = tabwriter.NewWriter(r.runCtx.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ONE OF\tSTATUS")
+	for _, group := range req.Groups {
+		status, configured := "missing", []string{}
+		for _, name := range group {
+			if slices.Contains(req.Configured, name) {
+				configured = append(configured, name)
+			}
+		}
+		if len(configured) > 0 {
+			status = "configured: " + strings.Join(configured, ", ")
+		}
+		fmt.Fprintf(w, "%s\t%s\n", strings.Join(group, ", "), status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(r.runCtx.Out, "\nMinimal sets of integrations satisfying every chart:")
+	for _, set := range req.Sets {
+		fmt.Fprintf(r.runCtx.Out, "  %s\n", strings.Join(set, ", "))
+	}
+	if req.Met {
+		fmt.Fprintln(r.runCtx.Out, "\nThe configured integrations satisfy the requirements.")
+		return nil
+	}
+	missing := []string{}
+	for _, group := range req.Missing() {
+		missing = append(missing, strings.Join(group, "/"))
+	}
+	fmt.Fprintf(r.runCtx.Out, "\nMissing integrations, one of each: %s\n",
+		strings.Join(missing, "; "))
+	return nil
+}
+
+// NewIntegrationRequirements instantiates the "integration requirements"
+// subcommand.
+func NewIntegrationRequirements(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationRequirements {
+	return &IntegrationRequirements{
+		cmd: &cobra.Command{
+			Use:          "requirements",
+			Short:        "Shows the integrations to configure for the topology",
+			Long:         integrationRequirementsDesc,
+			Args:         cobra.NoArgs,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+}
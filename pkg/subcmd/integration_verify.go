@@ -0,0 +1,197 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/verify"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+)
+
+// verifyFlag flag name to verify the integration credentials once stored.
+const verifyFlag = "verify"
+
+// IntegrationVerify represents the "integration verify" subcommand, it asserts
+// the stored integration credentials work against the actual services.
+type IntegrationVerify struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	cfg      *config.Config // installer configuration
+	names    []string       // integrations to verify
+	explicit bool           // integrations informed as arguments
+}
+
+var _ api.SubCommand = (*IntegrationVerify)(nil)
+
+const integrationVerifyDesc = `
+Verifies the configured integrations credentials, with a cheap authenticated call
+to each service: the container registry APIs, the ACS Central API, the Jenkins,
+Bitbucket, GitLab and GitHub user or app endpoints, and the Trusted Artifact
+Signer Rekor and TUF endpoints.
+
+By default all configured integrations supporting verification are verified, or
+only the informed ones. The command exits with error when any fails.
+`
+
+// Cmd exposes the cobra instance.
+func (v *IntegrationVerify) Cmd() *cobra.Command {
+	return v.cmd
+}
+
+// Complete loads the cluster configuration and the integrations to verify.
+func (v *IntegrationVerify) Complete(args []string) error {
+	var err error
+	manager := config.NewConfigMapManager(
+		v.runCtx.Kube, v.appCtx.Name, v.runCtx.Flags.Instance)
+	if v.cfg, err = manager.GetConfig(v.cmd.Context()); err != nil {
+		return err
+	}
+	v.names, v.explicit = args, len(args) > 0
+	if !v.explicit {
+		v.names = verify.Names()
+	}
+	return nil
+}
+
+// Validate asserts the informed integrations support verification.
+func (v *IntegrationVerify) Validate() error {
+	for _, name := range v.names {
+		if _, ok := verify.For(name); !ok {
+			return fmt.Errorf("integration %q doesn't support verification, "+
+				"expected one of %v", name, verify.Names())
+		}
+	}
+	return nil
+}
+
+// Run verifies the integrations, printing the outcome of each.
+func (v *IntegrationVerify) Run() error {
+	ctx := v.cmd.Context()
+	client := verify.NewClient(nil)
+	var failed []string
+	for _, name := range slices.Sorted(slices.Values(v.names)) {
+		i, err := integrations.Lookup(v.runCtx.Integrations, name)
+		if err != nil {
+			return err
+		}
+		secret, err := i.Get(ctx, v.cfg)
+		if err != nil {
+			return err
+		}
+		switch {
+		case secret == nil && v.explicit:
+			err = errors.New("not configured")
+		case secret == nil:
+			continue
+		default:
+			err = client.Verify(ctx, name, secret.Data)
+		}
+		if err != nil {
+			failed = append(failed, name)
+			fmt.Fprintf(v.runCtx.Out, "%-24s FAILED: %s\n", name, err)
+			continue
+		}
+		fmt.Fprintf(v.runCtx.Out, "%-24s OK\n", name)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%w: %v", verify.ErrVerificationFailed, failed)
+	}
+	return nil
+}
+
+// NewIntegrationVerify instantiates the "integration verify" subcommand.
+func NewIntegrationVerify(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *IntegrationVerify {
+	return &IntegrationVerify{
+		cmd: &cobra.Command{
+			Use:          "verify [name...]",
+			Short:        "Verifies the integrations credentials",
+			Long:         integrationVerifyDesc,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+}
+
+// integrationVerify registers "integration verify", and adds "--verify" to the
+// integration subcommands supporting it, verifying the credentials informed
+// before the integration secret is stored. The GitHub App credentials only exist
+// once "integration github" creates the application, it's verified later on.
+func integrationVerify(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	integration, _, err := root.Find([]string{"integration"})
+	if err != nil || integration == root {
+		return
+	}
+	for _, name := range verify.Names() {
+		if name == "github" {
+			continue
+		}
+		cmd, _, err := integration.Find([]string{name})
+		if err != nil || cmd.Name() != name || cmd.RunE == nil {
+			continue
+		}
+		var enabled bool
+		cmd.Flags().BoolVar(&enabled, verifyFlag, false,
+			"Verify the credentials against the service before storing")
+		preRunE := cmd.PreRunE
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			if preRunE != nil {
+				if err := preRunE(c, args); err != nil {
+					return err
+				}
+			}
+			if !enabled || runCtx.Flags.DryRun {
+				return nil
+			}
+			return verifyInformed(c, appCtx, runCtx, name)
+		}
+	}
+	integration.AddCommand(api.NewRunner(NewIntegrationVerify(appCtx, runCtx)).Cmd())
+}
+
+// verifyInformed verifies the credentials informed on the command line, with
+// the integration secret payload, before it's stored.
+func verifyInformed(
+	cmd *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	name string,
+) error {
+	ctx := cmd.Context()
+	cfg, err := config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance).
+		GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	i, err := integrations.Lookup(runCtx.Integrations, name)
+	if err != nil {
+		return err
+	}
+	secret, err := i.Secret(ctx, runCtx.RunContext, cfg)
+	if err != nil {
+		return err
+	}
+	runCtx.Logger.Info("Verifying the integration credentials",
+		"integration", name)
+	if err = verify.NewClient(nil).Verify(ctx, name, secret.Data); err != nil {
+		return fmt.Errorf("integration %q isn't stored: %w", name, err)
+	}
+	runCtx.Logger.Info("Integration credentials verified", "integration", name)
+	return nil
+}
@@ -0,0 +1,65 @@
+package subcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/verify"
+)
+
+func TestIntegrationVerify_Flag(t *testing.T) {
+	jenkins := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			username, token, _ := r.BasicAuth()
+			if r.URL.Path != "/whoAmI/api/json" || token != "token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"name": username, "authenticated": true,
+			})
+		}))
+	t.Cleanup(jenkins.Close)
+
+	tests := []struct {
+		name    string   // test case name
+		token   string   // jenkins token informed
+		secrets []string // secrets expected on the cluster
+		err     error    // error expected, if any
+	}{{
+		name:    "credentials accepted are stored",
+		token:   "token",
+		secrets: []string{"tssc-jenkins-integration"},
+	}, {
+		name:    "credentials rejected aren't stored",
+		token:   "wrong",
+		secrets: []string{},
+		err:     verify.ErrVerificationFailed,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(t)
+			out, err := app.run(t, "integration", "jenkins",
+				"--url", jenkins.URL,
+				"--username", "admin",
+				"--token", tt.token,
+				"--verify",
+			)
+			switch {
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Fatalf("integration jenkins = %v, want %v\n%s", err, tt.err, out)
+			case tt.err == nil && err != nil:
+				t.Fatalf("integration jenkins = %v\n%s", err, out)
+			}
+			created := app.kube.created("secrets")
+			if !slices.Equal(created, tt.secrets) {
+				t.Errorf("secrets created = %v, want %v", created, tt.secrets)
+			}
+		})
+	}
+}
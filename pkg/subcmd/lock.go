@@ -0,0 +1,140 @@
+package subcmd
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/lock"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+)
+
+// breakLockFlag flag name to take over the installation lock held by others.
+const breakLockFlag = "break-lock"
+
+// lockGuard takes the installation lock before the commands changing the
+// cluster state run, the lock is released when the command execution finishes.
+type lockGuard struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+	lock   *lock.Lock // lock held by this process, if any
+}
+
+// readOnlyIntegrations "integration" subcommands not changing the cluster, thus
+// not taking the lock.
+var readOnlyIntegrations = []string{"describe", "list", "requirements", "verify"}
+
+// lockFilterFn decides whether the command invocation requires the lock.
+type lockFilterFn func(cmd *cobra.Command) bool
+
+// commandLine returns the command path with the informed arguments.
+func commandLine(cmd *cobra.Command, args []string) []string {
+	return append(strings.Fields(cmd.CommandPath()), args...)
+}
+
+// acquire takes the installation lock for the command. Without a cluster
+// configuration there's no installation to protect, and dry-run mode doesn't
+// change the cluster, the lock is skipped in both cases.
+func (g *lockGuard) acquire(cmd *cobra.Command, args []string) error {
+	if g.runCtx.Flags.DryRun {
+		return nil
+	}
+	if g.lock != nil && g.lock.Held() {
+		return nil
+	}
+	manager := config.NewConfigMapManager(
+		g.runCtx.Kube, g.appCtx.Name, g.runCtx.Flags.Instance)
+	cm, err := manager.GetConfigMap(cmd.Context())
+	if err != nil {
+		if errors.Is(err, config.ErrConfigMapNotFound) {
+			g.runCtx.Logger.Debug("Cluster configuration not found, skipping lock")
+			return nil
+		}
+		return err
+	}
+	breakLock, _ := cmd.Flags().GetBool(breakLockFlag)
+	g.lock = lock.NewLock(g.runCtx.Logger, g.runCtx.Kube, g.appCtx.Name,
+		cm.GetNamespace(), commandLine(cmd, args))
+	ctx, err := g.lock.Acquire(cmd.Context(), breakLock)
+	if err != nil {
+		return err
+	}
+	// The command runs on the lock holder context, cancelled when the lock is
+	// lost, stopping the command changes.
+	cmd.SetContext(ctx)
+	return nil
+}
+
+// release releases the installation lock, when held.
+func (g *lockGuard) release() {
+	if g.lock != nil {
+		g.lock.Release()
+	}
+}
+
+// wrap takes the lock before the command's pre-run, when the filter allows. When
+// the lock is lost while running, the command fails with the reason.
+func (g *lockGuard) wrap(cmd *cobra.Command, filter lockFilterFn) {
+	if cmd.Flags().Lookup(breakLockFlag) == nil {
+		cmd.Flags().Bool(breakLockFlag, false,
+			"Take over the installation lock held by others")
+	}
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if filter == nil || filter(c) {
+			if err := g.acquire(c, args); err != nil {
+				return err
+			}
+		}
+		if preRunE != nil {
+			return preRunE(c, args)
+		}
+		return nil
+	}
+	runE := cmd.RunE
+	if runE == nil {
+		return
+	}
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		err := runE(c, args)
+		if cause := context.Cause(c.Context()); errors.Is(cause, lock.ErrLockLost) {
+			return cause
+		}
+		return err
+	}
+}
+
+// lockCommands guards the commands changing the installation with the cluster
+// wide lock: "deploy", "upgrade", "config --force" and each "integration".
+func lockCommands(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	g := &lockGuard{appCtx: appCtx, runCtx: runCtx}
+	cobra.OnFinalize(g.release)
+
+	for _, name := range []string{"deploy", "upgrade"} {
+		if cmd, _, err := root.Find([]string{name}); err == nil && cmd != root {
+			g.wrap(cmd, nil)
+		}
+	}
+	if cmd, _, err := root.Find([]string{"config"}); err == nil && cmd != root {
+		g.wrap(cmd, func(c *cobra.Command) bool {
+			force, _ := c.Flags().GetBool("force")
+			return force
+		})
+	}
+	if cmd, _, err := root.Find([]string{"integration"}); err == nil && cmd != root {
+		for _, sub := range cmd.Commands() {
+			if !slices.Contains(readOnlyIntegrations, sub.Name()) {
+				g.wrap(sub, nil)
+			}
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/offline"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// offlineCommands commands rendering the installation, able to run without a
+// cluster. The "config" subcommand only runs offline on dry-run.
+var offlineCommands = []string{"config", "render", "template", "topology"}
+
+// offlineMode runs the rendering commands without a cluster. The installer
+// configuration is loaded from a local file, the OpenShift facts are informed
+// by flags or a facts file, and the "lookup" function is backed by a fixtures
+// directory. These are served by the fake Kubernetes client, replacing the run
+// context client.
+type offlineMode struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	enabled     bool                   // offline mode toggle
+	configPath  string                 // local configuration file
+	factsPath   string                 // OpenShift facts file
+	fixturesDir string                 // lookup fixtures directory
+	facts       offline.OpenShiftFacts // OpenShift facts flags
+}
+
+// addFlags registers the offline mode flags on the command.
+func (o *offlineMode) addFlags(cmd *cobra.Command) {
+	p := cmd.Flags()
+	p.BoolVar(&o.enabled, "offline", false,
+		"Render without cluster access, implied by the other offline flags")
+	// The "config" subcommand informs the configuration file as argument.
+	if cmd.Name() != "config" {
+		p.StringVar(&o.configPath, "config", "", fmt.Sprintf(
+			"Local installer configuration file, offline (default %q)",
+			config.Filename))
+	}
+	p.StringVar(&o.factsPath, "facts", "",
+		`OpenShift facts file, offline, with "ingressDomain", "routerCA" and "version"`)
+	p.StringVar(&o.fixturesDir, "fixtures", "",
+		`Directory of Kubernetes objects YAML files, served to "lookup" offline`)
+	p.StringVar(&o.facts.IngressDomain, "set-openshift-domain", "",
+		"OpenShift ingress domain, offline")
+	p.StringVar(&o.facts.Version, "set-openshift-version", "",
+		"OpenShift version, offline")
+	p.StringVar(&o.facts.RouterCA, "set-openshift-router-ca", "",
+		"OpenShift ingress router CA, PEM or base64 encoded, offline")
+}
+
+// active checks whether the offline mode is requested, any of the offline
+// flags enables it.
+func (o *offlineMode) active() bool {
+	return o.enabled || o.configPath != "" || o.factsPath != "" ||
+		o.fixturesDir != "" || o.facts != offline.OpenShiftFacts{}
+}
+
+// openShiftFacts returns the facts file attributes, overridden by the flags.
+func (o *offlineMode) openShiftFacts() (*offline.OpenShiftFacts, error) {
+	facts := &offline.OpenShiftFacts{}
+	if o.factsPath != "" {
+		var err error
+		if facts, err = offline.LoadFacts(o.factsPath); err != nil {
+			return nil, err
+		}
+	}
+	if o.facts.IngressDomain != "" {
+		facts.IngressDomain = o.facts.IngressDomain
+	}
+	if o.facts.Version != "" {
+		facts.Version = o.facts.Version
+	}
+	if o.facts.RouterCA != "" {
+		facts.RouterCA = offline.EncodeCA(o.facts.RouterCA)
+	}
+	return facts, nil
+}
+
+// objects returns the objects served offline: the installer configuration, the
+// OpenShift facts, and the fixtures, which take precedence.
+func (o *offlineMode) objects() ([]*unstructured.Unstructured, error) {
+	configPath := o.configPath
+	if configPath == "" {
+		configPath = config.Filename
+	}
+	payload, err := o.runCtx.ChartFS.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	// Asserting the configuration is valid before serving it, scoped by the
+	// installation instance as the "config" subcommand creates it.
+	instance := o.runCtx.Flags.Instance
+	namespace := o.runCtx.Flags.Scoped(o.appCtx.Namespace)
+	cfg, err := config.NewConfigFromBytes(
+		payload, namespace, o.appCtx.IdentifierName())
+	if err != nil {
+		return nil, err
+	}
+	if err = cfg.ScopeNamespaces(instance); err != nil {
+		return nil, err
+	}
+	objects := []*unstructured.Unstructured{
+		offline.ConfigObject(
+			o.appCtx.Name, instance, namespace, []byte(cfg.String())),
+	}
+
+	facts, err := o.openShiftFacts()
+	if err != nil {
+		return nil, err
+	}
+	missing := []string{}
+	for name, value := range map[string]string{
+		"ingressDomain": facts.IngressDomain,
+		"routerCA":      facts.RouterCA,
+		"version":       facts.Version,
+	} {
+		if value == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		o.runCtx.Logger.Warn("Incomplete OpenShift facts, charts may fail to "+
+			"render, inform --facts or --set-openshift-* flags", "missing", missing)
+	}
+	factsObjects, err := offline.FactsObjects(facts)
+	if err != nil {
+		return nil, err
+	}
+	objects = append(objects, factsObjects...)
+
+	if o.fixturesDir != "" {
+		fixtures, err := offline.LoadFixtures(o.fixturesDir)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, fixtures...)
+	}
+	return objects, nil
+}
+
+// start replaces the run context Kubernetes client with the offline one, for the
+// remainder of the execution.
+func (o *offlineMode) start(cmd *cobra.Command) error {
+	if cmd.Root().PersistentFlags().Changed(api.KubeConfigFlag) {
+		return errors.New("offline mode doesn't use a cluster, --kube-config " +
+			"is not expected")
+	}
+	if cmd.Name() == "config" && !o.runCtx.Flags.DryRun {
+		return errors.New("offline mode only shows the configuration, " +
+			"--dry-run is expected")
+	}
+	objects, err := o.objects()
+	if err != nil {
+		return err
+	}
+	o.runCtx.Logger.Debug("Rendering offline",
+		"config", o.configPath, "facts", o.factsPath, "fixtures", o.fixturesDir)
+	o.runCtx.Kube = offline.NewKube(objects...)
+	return nil
+}
+
+// offlineRendering adds the offline mode to the commands rendering the
+// installation, for chart development without a cluster.
+func offlineRendering(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	for _, name := range offlineCommands {
+		cmd, _, err := root.Find([]string{name})
+		if err != nil || cmd == root {
+			continue
+		}
+		o := &offlineMode{appCtx: appCtx, runCtx: runCtx}
+		o.addFlags(cmd)
+
+		preRunE := cmd.PreRunE
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			if o.active() {
+				if err := o.start(c); err != nil {
+					return err
+				}
+			}
+			if preRunE != nil {
+				return preRunE(c, args)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package subcmd
+
+import (
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+)
+
+// showSecretsFlag flag name to show the sensitive values unmasked.
+const showSecretsFlag = "show-secrets"
+
+// renderingCommands commands printing the rendered installer values and release
+// manifests, on debug and dry-run modes, sourced from the integration secrets.
+var renderingCommands = []string{"deploy", "template", "upgrade"}
+
+// redactSecrets registers "--show-secrets", masking the sensitive values printed
+// unless informed, and registers the integration secrets values on the redactor
+// before the commands rendering them run.
+func redactSecrets(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	showSecrets := root.PersistentFlags().Bool(showSecretsFlag, false,
+		"Show sensitive values, such as tokens and passwords, unmasked on the "+
+			"logs, debug and dry-run output")
+	runCtx.Redactor.SetEnabled(func() bool {
+		return !*showSecrets
+	})
+
+	for _, name := range renderingCommands {
+		cmd, _, err := root.Find([]string{name})
+		if err != nil || cmd == root {
+			continue
+		}
+		preRunE := cmd.PreRunE
+		cmd.PreRunE = func(c *cobra.Command, args []string) error {
+			redactIntegrationValues(c, appCtx, runCtx)
+			if preRunE != nil {
+				return preRunE(c, args)
+			}
+			return nil
+		}
+	}
+}
+
+// redactIntegrationValues registers the configured integration secrets values
+// on the redactor, the non-sensitive ones, like URLs, are kept visible. It's a
+// best effort, without a cluster configuration there are no integrations.
+func redactIntegrationValues(
+	cmd *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	if !runCtx.Redactor.Enabled() {
+		return
+	}
+	ctx := cmd.Context()
+	cfg, err := config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance).
+		GetConfig(ctx)
+	if err != nil {
+		runCtx.Logger.Debug("Skipping integration secrets redaction",
+			"reason", err)
+		return
+	}
+	secrets, err := integrations.ConfiguredSecrets(
+		ctx, runCtx.Integrations, cfg)
+	if err != nil {
+		runCtx.Logger.Debug("Skipping integration secrets redaction",
+			"reason", err)
+		return
+	}
+	for _, secret := range secrets {
+		for key, value := range secret.Data {
+			if !api.IsPublicIntegrationKey(key) {
+				runCtx.Redactor.AddValues(value)
+			}
+		}
+	}
+}
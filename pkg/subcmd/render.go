@@ -0,0 +1,171 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/render"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/engine"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+// Render represents the "render" subcommand, it renders the whole installation
+// into a GitOps repository layout, for ArgoCD to apply.
+type Render struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	cfg      *config.Config     // installer configuration
+	topology *resolver.Topology // resolved dependencies
+	values   chartutil.Values   // values passed to every chart
+
+	valuesTemplatePath string        // values template file
+	gitops             render.GitOps // GitOps layout options
+}
+
+var _ api.SubCommand = (*Render)(nil)
+
+const renderDesc = `
+Renders the entire installation into a GitOps repository layout, for ArgoCD to
+apply the platform instead of the installer.
+
+The dependency topology is resolved from the cluster configuration, the same as
+"deploy" does, and each chart is rendered into plain manifests on a directory of
+its own, with a Kustomization. Helm hooks are kept, ArgoCD maps them onto sync
+phases, while Helm tests are only included with "--tests", as PostSync hooks.
+
+The ArgoCD Applications, one per chart, are written on the "argocd" directory,
+with sync waves following the topology order, and referenced by the root
+Kustomization, an app-of-apps for the output directory. Alternatively, with
+"--application-set", a single ApplicationSet is generated, syncing the charts
+wave by wave with the progressive syncs rolling strategy.
+
+The rendered manifests contain the installer secrets, encrypt them before
+committing to a repository.
+`
+
+// Cmd exposes the cobra instance.
+func (r *Render) Cmd() *cobra.Command {
+	return r.cmd
+}
+
+// log logger with contextual information.
+func (r *Render) log() *slog.Logger {
+	return r.runCtx.Flags.LoggerWith(r.runCtx.Logger.With(
+		"output-dir", r.gitops.Dir,
+		"application-set", r.gitops.ApplicationSet,
+	))
+}
+
+// Complete loads the cluster configuration, resolves the topology and renders
+// the values template.
+func (r *Render) Complete(_ []string) error {
+	ctx := r.cmd.Context()
+	var err error
+	manager := config.NewConfigMapManager(
+		r.runCtx.Kube, r.appCtx.Name, r.runCtx.Flags.Instance)
+	if r.cfg, err = manager.GetConfig(ctx); err != nil {
+		return err
+	}
+
+	charts, err := r.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	configured, err := integrations.Configured(
+		ctx, r.runCtx.Integrations, r.cfg)
+	if err != nil {
+		return err
+	}
+	r.topology, err = topology.ResolveCharts(r.appCtx, r.cfg, charts, configured)
+	if err != nil {
+		return err
+	}
+
+	valuesTmpl, err := r.runCtx.ChartFS.ReadFile(r.valuesTemplatePath)
+	if err != nil {
+		return fmt.Errorf("failed to read values template file: %w", err)
+	}
+	r.values, err = render.Values(
+		ctx, r.runCtx.Logger, r.runCtx.Kube, r.cfg, r.topology, string(valuesTmpl))
+	return err
+}
+
+// Validate asserts the GitOps layout options and the topology.
+func (r *Render) Validate() error {
+	if len(r.topology.Dependencies()) == 0 {
+		return errors.New("no charts to render, enable products on the configuration")
+	}
+	return r.gitops.Validate()
+}
+
+// Run renders the topology and writes the GitOps layout.
+func (r *Render) Run() error {
+	r.log().Debug("Rendering the topology",
+		"dependencies", len(r.topology.Dependencies()))
+	releases, err := render.NewRenderer(
+		r.runCtx.Logger, r.runCtx.Kube, r.runCtx.Flags, r.values).
+		RenderTopology(r.cmd.Context(), r.topology)
+	if err != nil {
+		return err
+	}
+	if err = r.gitops.Write(r.appCtx.Name, releases); err != nil {
+		return err
+	}
+	fmt.Fprintf(r.runCtx.Out, "Rendered %d chart(s) on %q.\n", len(releases), r.gitops.Dir)
+	return nil
+}
+
+// NewRender instantiates the "render" subcommand.
+func NewRender(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *Render {
+	r := &Render{
+		cmd: &cobra.Command{
+			Use:          "render --output-dir DIR --repo-url URL",
+			Short:        "Renders the installation into a GitOps repository layout",
+			Long:         renderDesc,
+			SilenceUsage: true,
+		},
+		appCtx:             appCtx,
+		runCtx:             runCtx,
+		valuesTemplatePath: engine.ValuesFilename,
+		gitops: render.GitOps{
+			RepoPath:        ".",
+			TargetRevision:  "HEAD",
+			Project:         "default",
+			ArgoCDNamespace: "openshift-gitops",
+		},
+	}
+	p := r.cmd.PersistentFlags()
+	p.StringVar(&r.valuesTemplatePath, "values-template", r.valuesTemplatePath,
+		"Path to the values template file")
+	p.StringVar(&r.gitops.Dir, "output-dir", r.gitops.Dir,
+		"Directory to write the GitOps layout")
+	p.StringVar(&r.gitops.RepoURL, "repo-url", r.gitops.RepoURL,
+		"GitOps repository URL, ArgoCD syncs from")
+	p.StringVar(&r.gitops.RepoPath, "repo-path", r.gitops.RepoPath,
+		"Path of the output directory on the GitOps repository")
+	p.StringVar(&r.gitops.TargetRevision, "target-revision",
+		r.gitops.TargetRevision, "GitOps repository revision ArgoCD syncs")
+	p.StringVar(&r.gitops.Project, "project", r.gitops.Project,
+		"ArgoCD project of the Applications")
+	p.StringVar(&r.gitops.ArgoCDNamespace, "argocd-namespace",
+		r.gitops.ArgoCDNamespace, "Namespace of the ArgoCD Applications")
+	p.BoolVar(&r.gitops.ApplicationSet, "application-set",
+		r.gitops.ApplicationSet, "Generate an ApplicationSet instead of Applications")
+	p.BoolVar(&r.gitops.Tests, "tests", r.gitops.Tests,
+		"Include the Helm chart tests as PostSync hooks")
+	return r
+}
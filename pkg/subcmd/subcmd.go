@@ -0,0 +1,87 @@
+package subcmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/framework"
+	"github.com/spf13/cobra"
+)
+
+// AddCommands registers the tssc-specific subcommands on the root command
+// created by the installer framework, extends the integration subcommands, masks
+// the sensitive values printed, renders offline on demand, and guards the
+// commands changing the installation with the cluster-wide lock. The secret
+// writer is the one informed to the framework, "framework.WithSecretWriter".
+func AddCommands(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	writer *SecretWriter,
+) {
+	subs := []api.SubCommand{
+		NewUpgrade(appCtx, runCtx),
+		NewDrift(appCtx, runCtx),
+		NewRender(appCtx, runCtx),
+	}
+	for _, sub := range subs {
+		root.AddCommand(api.NewRunner(sub).Cmd())
+	}
+	chartCommands(root, appCtx, runCtx)
+	integrationGitLabApp(root, appCtx, runCtx)
+	integrationGitHub(root, appCtx, runCtx)
+	integrationVerify(root, appCtx, runCtx)
+	integrationApply(root, appCtx, runCtx)
+	integrationInventory(root, appCtx, runCtx)
+	integrationOutput(root, appCtx, runCtx, writer)
+	templateAll(root, appCtx, runCtx)
+	topologyOutput(root, appCtx, runCtx)
+	redactSecrets(root, appCtx, runCtx)
+	offlineRendering(root, appCtx, runCtx)
+	lockCommands(root, appCtx, runCtx)
+}
+
+// runSubCommand runs a sibling subcommand, registered on the root command by
+// the installer framework, following its Complete, Validate and Run lifecycle.
+// The subcommand flags keep their default values.
+func runSubCommand(
+	ctx context.Context,
+	cmd *cobra.Command,
+	args ...string,
+) error {
+	sub, subArgs, err := cmd.Root().Find(args)
+	if err != nil {
+		return err
+	}
+	if sub == cmd.Root() || sub.RunE == nil {
+		return fmt.Errorf("subcommand %q not found", strings.Join(args, " "))
+	}
+	sub.SetContext(ctx)
+	if sub.PreRunE != nil {
+		if err = sub.PreRunE(sub, subArgs); err != nil {
+			return err
+		}
+	}
+	return sub.RunE(sub, subArgs)
+}
+
+// integrationContext loads the installer configuration of the instance, and
+// returns the framework IntegrationContext the URL providers are called with,
+// so the subcommands resolve the same endpoints as the integration modules.
+func integrationContext(
+	ctx context.Context,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) (api.IntegrationContext, error) {
+	cfg, err := config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance).GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return framework.NewIntegrationContext(runCtx.RunContext, cfg), nil
+}
@@ -0,0 +1,127 @@
+package subcmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/offline"
+	"github.com/redhat-appstudio/tssc-cli/pkg/redact"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/chartfs"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"github.com/redhat-appstudio/helmet/framework"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// testNamespace the installer namespace of the test cluster.
+const testNamespace = "tssc"
+
+// testKube serves the core objects from a single clientset, keeping the writes,
+// unlike the fake client. The clientset actions tell what reached the cluster.
+type testKube struct {
+	*k8s.FakeKube
+	cs *fake.Clientset
+}
+
+func (k *testKube) ClientSet(string) (kubernetes.Interface, error) {
+	return k.cs, nil
+}
+
+func (k *testKube) CoreV1ClientSet(string) (corev1client.CoreV1Interface, error) {
+	return k.cs.CoreV1(), nil
+}
+
+// created returns the names of the objects of the resource created on the
+// cluster.
+func (k *testKube) created(resource string) []string {
+	names := []string{}
+	for _, a := range k.cs.Actions() {
+		create, ok := a.(clienttesting.CreateAction)
+		if !ok || create.GetResource().Resource != resource {
+			continue
+		}
+		if obj, err := meta.Accessor(create.GetObject()); err == nil {
+			names = append(names, obj.GetName())
+		}
+	}
+	return names
+}
+
+// testApp the installer application, with the tssc subcommands, on a test
+// cluster holding the installer configuration and the informed objects.
+type testApp struct {
+	root   *cobra.Command         // root command
+	appCtx *api.AppContext        // application context
+	runCtx *runcontext.RunContext // tssc run context
+	kube   *testKube              // test cluster
+	out    *bytes.Buffer          // application output
+}
+
+// run runs the command line, returning the output.
+func (a *testApp) run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	a.out.Reset()
+	a.root.SetArgs(args)
+	a.root.SetOut(a.out)
+	a.root.SetErr(a.out)
+	err := a.root.ExecuteContext(context.TODO())
+	return a.out.String(), err
+}
+
+// newTestApp instantiates the installer application for the tests, with the
+// installer charts and the default configuration.
+func newTestApp(t *testing.T, objects ...runtime.Object) *testApp {
+	t.Helper()
+	appCtx := api.NewAppContext("tssc")
+	out := &bytes.Buffer{}
+	writer := NewSecretWriter()
+	app, err := framework.NewApp(
+		appCtx,
+		chartfs.New(os.DirFS("../../installer")),
+		framework.WithIntegrations(framework.StandardIntegrations()...),
+		framework.WithMCPImage("tssc:test"),
+		framework.WithSecretWriter(writer),
+		framework.WithOutput(out),
+	)
+	if err != nil {
+		t.Fatalf("instantiating the application: %v", err)
+	}
+
+	payload, err := os.ReadFile("../../installer/config.yaml")
+	if err != nil {
+		t.Fatalf("reading the installer configuration: %v", err)
+	}
+	cm := &corev1.ConfigMap{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(
+		offline.ConfigObject(appCtx.Name, app.Flags().Instance, testNamespace,
+			payload).Object, cm)
+	if err != nil {
+		t.Fatalf("converting the configuration: %v", err)
+	}
+	kube := &testKube{
+		FakeKube: k8s.NewFakeKube(),
+		cs:       fake.NewClientset(append(objects, cm)...),
+	}
+	app.RunContext().Kube = kube
+
+	runCtx := runcontext.NewRunContext(app, redact.NewRedactor())
+	AddCommands(app.Command(), appCtx, runCtx, writer)
+	return &testApp{
+		root:   app.Command(),
+		appCtx: appCtx,
+		runCtx: runCtx,
+		kube:   kube,
+		out:    out,
+	}
+}
@@ -0,0 +1,177 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/render"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/engine"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"sigs.k8s.io/yaml"
+)
+
+// templateTopology renders the whole dependency topology, or the topology of the
+// informed products, on the "template" subcommand. Each chart is rendered on its
+// target namespace, in the order "deploy" installs them.
+type templateTopology struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	all       bool     // render every dependency
+	products  []string // render the products dependencies
+	outputDir string   // per-chart file tree directory
+
+	topology *resolver.Topology // resolved dependencies
+	values   chartutil.Values   // values passed to every chart
+}
+
+// enabled checks whether the topology rendering is requested.
+func (t *templateTopology) enabled() bool {
+	return t.all || len(t.products) > 0
+}
+
+// addFlags registers the topology rendering flags on the "template" subcommand.
+func (t *templateTopology) addFlags(cmd *cobra.Command) {
+	p := cmd.Flags()
+	p.BoolVar(&t.all, "all", false,
+		"Render every chart of the dependency topology, in order")
+	p.StringSliceVar(&t.products, "product", nil,
+		"Render the product charts, and the charts they depend on, in order")
+	p.StringVar(&t.outputDir, "output-dir", "",
+		"Write a directory per chart, instead of a multi-document stream")
+}
+
+// complete loads the configuration, resolves the topology and renders the
+// values template.
+func (t *templateTopology) complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return errors.New("charts are resolved from the topology, " +
+			"no chart argument is expected with --all or --product")
+	}
+	ctx := cmd.Context()
+	cfg, err := config.NewConfigMapManager(
+		t.runCtx.Kube, t.appCtx.Name, t.runCtx.Flags.Instance).
+		GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	charts, err := t.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	configured, err := integrations.Configured(
+		ctx, t.runCtx.Integrations, cfg)
+	if err != nil {
+		return err
+	}
+	resolved, err := topology.ResolveCharts(t.appCtx, cfg, charts, configured)
+	if err != nil {
+		return err
+	}
+	t.topology = resolved
+	if !t.all {
+		t.topology, err = topology.ProductSubset(resolved, t.products...)
+		if err != nil {
+			return err
+		}
+	}
+	if len(t.topology.Dependencies()) == 0 {
+		return errors.New("no charts to render, enable products on the configuration")
+	}
+
+	valuesTmplPath := engine.ValuesFilename
+	if f := cmd.Flags().Lookup("values-template"); f != nil {
+		valuesTmplPath = f.Value.String()
+	}
+	valuesTmpl, err := t.runCtx.ChartFS.ReadFile(valuesTmplPath)
+	if err != nil {
+		return fmt.Errorf("failed to read values template file: %w", err)
+	}
+	// The values are rendered for the whole topology, as "deploy" does, not
+	// only the products subset.
+	t.values, err = render.Values(
+		ctx, t.runCtx.Logger, t.runCtx.Kube, cfg, resolved, string(valuesTmpl))
+	return err
+}
+
+// run renders the topology, printing the values when requested, and writes the
+// manifests as a stream or per-chart tree.
+func (t *templateTopology) run(cmd *cobra.Command) error {
+	if showValues, _ := cmd.Flags().GetBool("show-values"); showValues {
+		payload, err := yaml.Marshal(t.values)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(t.runCtx.Out, "#\n# Values\n#\n---\n%s", payload)
+	}
+	if showManifests, _ := cmd.Flags().GetBool("show-manifests"); !showManifests {
+		return nil
+	}
+
+	releases, err := render.NewRenderer(
+		t.runCtx.Logger, t.runCtx.Kube, t.runCtx.Flags, t.values).
+		RenderTopology(cmd.Context(), t.topology)
+	if err != nil {
+		return err
+	}
+	if t.outputDir == "" {
+		return render.WriteStream(t.runCtx.Out, releases)
+	}
+	if err = render.WriteTree(t.outputDir, releases); err != nil {
+		return err
+	}
+	fmt.Fprintf(t.runCtx.Out, "Rendered %d chart(s) on %q.\n", len(releases), t.outputDir)
+	return nil
+}
+
+// templateAll extends the "template" subcommand with "--all" and "--product",
+// rendering the dependency topology instead of a single chart.
+func templateAll(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	cmd, _, err := root.Find([]string{"template"})
+	if err != nil || cmd == root || cmd.RunE == nil {
+		return
+	}
+	t := &templateTopology{appCtx: appCtx, runCtx: runCtx}
+	t.addFlags(cmd)
+	cmd.Example = fmt.Sprintf(`  # Rendering every chart, in order, using the cluster configuration.
+  $ %[1]s template --all --show-values=false
+
+  # Rendering a product charts without cluster access, into a directory tree.
+  $ %[1]s template --product "Developer Hub" --config installer/config.yaml \
+      --facts facts.yaml --fixtures fixtures/ --output-dir preview/`,
+		appCtx.Name)
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if t.enabled() {
+			return t.complete(c, args)
+		}
+		if t.outputDir != "" {
+			return errors.New("--output-dir requires --all or --product")
+		}
+		if preRunE != nil {
+			return preRunE(c, args)
+		}
+		return nil
+	}
+	runE := cmd.RunE
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		if t.enabled() {
+			return t.run(c)
+		}
+		return runE(c, args)
+	}
+}
@@ -0,0 +1,128 @@
+package subcmd
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// offlineArgs the offline rendering flags, the installer configuration and the
+// chart test suite facts and fixtures, no cluster access.
+var offlineArgs = []string{
+	"--show-values=false",
+	"--config", "../../installer/config.yaml",
+	"--facts", "../../test/charts/facts.yaml",
+	"--fixtures", "../../test/charts/fixtures",
+}
+
+// releaseHeaderRe matches the release header of the multi-document stream.
+var releaseHeaderRe = regexp.MustCompile(`(?m)^# Release: (.+)$`)
+
+// releaseHeaders returns the release headers of the stream, in order.
+func releaseHeaders(out string) []string {
+	headers := []string{}
+	for _, m := range releaseHeaderRe.FindAllStringSubmatch(out, -1) {
+		headers = append(headers, m[1])
+	}
+	return headers
+}
+
+func TestTemplateTopology(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		app := newTestApp(t)
+		out, err := app.run(t, append([]string{"template", "--all"}, offlineArgs...)...)
+		if err != nil {
+			t.Fatalf("template --all = %v\n%s", err, out)
+		}
+		want := []string{
+			`tssc-openshift (namespace "tssc")`,
+			`tssc-subscriptions (namespace "tssc")`,
+			`tssc-acs (namespace "tssc-acs")`,
+			`tssc-gitops (namespace "tssc-gitops")`,
+			`tssc-infrastructure (namespace "tssc")`,
+			`tssc-iam (namespace "tssc")`,
+			`tssc-tas (namespace "tssc-tas")`,
+			`tssc-pipelines-config (namespace "tssc")`,
+			`tssc-pipelines (namespace "tssc")`,
+			`tssc-tpa (namespace "tssc-tpa")`,
+			`tssc-app-namespaces (namespace "tssc")`,
+			`tssc-dh (namespace "tssc-dh")`,
+			`tssc-integrations (namespace "tssc")`,
+			`tssc-acs-test (namespace "tssc-acs")`,
+		}
+		if got := releaseHeaders(out); !slices.Equal(got, want) {
+			t.Errorf("release headers =\n%s\nwant:\n%s",
+				strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+		// Each release carries its own manifests, on its target namespace.
+		for _, section := range strings.Split(out, "# Release: ")[1:] {
+			name, _, _ := strings.Cut(section, " ")
+			if !strings.Contains(section, "# Source: "+name+"/") {
+				t.Errorf("release %q without its manifests", name)
+			}
+		}
+	})
+
+	t.Run("product", func(t *testing.T) {
+		app := newTestApp(t)
+		out, err := app.run(t, append([]string{
+			"template", "--product", "Advanced Cluster Security",
+		}, offlineArgs...)...)
+		if err != nil {
+			t.Fatalf("template --product = %v\n%s", err, out)
+		}
+		want := []string{
+			`tssc-openshift (namespace "tssc")`,
+			`tssc-subscriptions (namespace "tssc")`,
+			`tssc-acs (namespace "tssc-acs")`,
+		}
+		if got := releaseHeaders(out); !slices.Equal(got, want) {
+			t.Errorf("release headers =\n%s\nwant:\n%s",
+				strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("output-dir", func(t *testing.T) {
+		app := newTestApp(t)
+		dir := t.TempDir()
+		out, err := app.run(t, append([]string{
+			"template", "--product", "Advanced Cluster Security",
+			"--output-dir", dir,
+		}, offlineArgs...)...)
+		if err != nil {
+			t.Fatalf("template --output-dir = %v\n%s", err, out)
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []string{}
+		for _, e := range entries {
+			got = append(got, e.Name())
+		}
+		want := []string{"01-tssc-openshift", "02-tssc-subscriptions", "03-tssc-acs"}
+		if !slices.Equal(got, want) {
+			t.Errorf("directories = %v, want %v", got, want)
+		}
+		if _, err = os.Stat(
+			filepath.Join(dir, "03-tssc-acs", "manifests.yaml")); err != nil {
+			t.Errorf("release manifests: %v", err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"template", "--all", "tssc-acs"},
+			{"template", "--output-dir", t.TempDir(), "tssc-acs"},
+			{"template", "--product", "Unknown"},
+		} {
+			app := newTestApp(t)
+			if _, err := app.run(t, append(args, offlineArgs...)...); err == nil {
+				t.Errorf("%v: error expected", args)
+			}
+		}
+	})
+}
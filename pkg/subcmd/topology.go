@@ -0,0 +1,168 @@
+package subcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/integrations"
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// topologyFormats the "topology --output" formats, the table is the default.
+var topologyFormats = []string{"table", "dot", "mermaid", "json", "yaml"}
+
+// topologyExport exports the dependency topology as a graph, on the "topology"
+// subcommand, for documentation and tooling.
+type topologyExport struct {
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	output  string // output format
+	all     bool   // include the charts not installed
+	explain string // chart to explain the provenance
+
+	graph *topology.Graph // resolved topology graph
+}
+
+// enabled checks whether the graph export is requested, otherwise the table is
+// printed by the framework.
+func (t *topologyExport) enabled() bool {
+	return t.output != topologyFormats[0] || t.all || t.explain != ""
+}
+
+// addFlags registers the graph export flags on the "topology" subcommand.
+func (t *topologyExport) addFlags(cmd *cobra.Command) {
+	p := cmd.Flags()
+	p.StringVarP(&t.output, "output", "o", topologyFormats[0], fmt.Sprintf(
+		"Output format, one of: %s", strings.Join(topologyFormats, ", ")))
+	p.BoolVar(&t.all, "all", false,
+		"Include the charts not installed, as disabled products charts, greyed out")
+	p.StringVar(&t.explain, "explain", "",
+		"Explain why the chart is installed, or skipped, down to the products")
+}
+
+// complete validates the format, loads the configuration and resolves the
+// topology graph.
+func (t *topologyExport) complete(cmd *cobra.Command) error {
+	if !slices.Contains(topologyFormats, t.output) {
+		return fmt.Errorf("invalid output format %q, expected one of: %s",
+			t.output, strings.Join(topologyFormats, ", "))
+	}
+	if t.explain != "" && t.output != topologyFormats[0] {
+		return errors.New("--explain is not supported with --output, " +
+			"the reasons are part of the json and yaml representation")
+	}
+	cfg, err := config.NewConfigMapManager(
+		t.runCtx.Kube, t.appCtx.Name, t.runCtx.Flags.Instance).
+		GetConfig(cmd.Context())
+	if err != nil {
+		return err
+	}
+	charts, err := t.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	collection, err := resolver.NewCollection(t.appCtx, charts)
+	if err != nil {
+		return err
+	}
+	configured, err := integrations.Configured(
+		cmd.Context(), t.runCtx.Integrations, cfg)
+	if err != nil {
+		return err
+	}
+	resolved, err := topology.Resolve(cfg, collection, configured)
+	if err != nil {
+		return err
+	}
+	// Explaining requires the charts skipped as well.
+	t.graph, err = topology.NewGraph(
+		cfg, collection, resolved, configured, t.all || t.explain != "")
+	return err
+}
+
+// run writes the graph on the informed format.
+func (t *topologyExport) run() error {
+	if t.explain != "" {
+		return t.graph.WriteExplain(t.runCtx.Out, t.explain)
+	}
+	switch t.output {
+	case "dot":
+		return t.graph.WriteDOT(t.runCtx.Out)
+	case "mermaid":
+		return t.graph.WriteMermaid(t.runCtx.Out)
+	case "json":
+		payload, err := json.MarshalIndent(t.graph, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(t.runCtx.Out, "%s\n", payload)
+		return nil
+	case "yaml":
+		payload, err := yaml.Marshal(t.graph)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(t.runCtx.Out, "---\n%s", payload)
+		return nil
+	default:
+		return t.graph.WriteTable(t.runCtx.Out)
+	}
+}
+
+// topologyOutput extends the "topology" subcommand with "--output", "--all" and
+// "--explain", exporting the topology as a graph besides the table, and
+// explaining the provenance of the charts. The topology follows the framework's
+// order, the same "deploy" installs the charts in.
+func topologyOutput(
+	root *cobra.Command,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) {
+	cmd, _, err := root.Find([]string{"topology"})
+	if err != nil || cmd == root || cmd.RunE == nil {
+		return
+	}
+	t := &topologyExport{appCtx: appCtx, runCtx: runCtx}
+	t.addFlags(cmd)
+	cmd.Example = fmt.Sprintf(`  # Diagram of the installation, disabled products charts greyed out.
+  $ %[1]s topology --output mermaid --all
+
+  # Rendering the diagram without cluster access, using Graphviz.
+  $ %[1]s topology --offline --output dot | dot -Tsvg -o topology.svg
+
+  # Stable representation for tooling, with the provenance of each chart.
+  $ %[1]s topology --output json --all
+
+  # Explaining why a chart is installed, or skipped.
+  $ %[1]s topology --explain tssc-iam`,
+		appCtx.Name)
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if t.enabled() {
+			return t.complete(c)
+		}
+		if preRunE != nil {
+			return preRunE(c, args)
+		}
+		return nil
+	}
+	runE := cmd.RunE
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		if t.enabled() {
+			return t.run()
+		}
+		return runE(c, args)
+	}
+}
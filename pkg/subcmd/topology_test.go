@@ -0,0 +1,116 @@
+package subcmd
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/topology"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// update rewrites the golden files with the current output.
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// assertGolden compares the output with the golden file, rewriting it with
+// "-update".
+func assertGolden(t *testing.T, out, golden string) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(out), 0o644); err != nil {
+			t.Fatalf("updating %s: %v", golden, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading %s: %v", golden, err)
+	}
+	if out == string(want) {
+		return
+	}
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(want)),
+		B:        difflib.SplitLines(out),
+		FromFile: golden,
+		ToFile:   "output",
+		Context:  3,
+	})
+	t.Errorf("output differs from the golden file:\n%s", diff)
+}
+
+// disabledProductConfig writes the installer configuration with the Developer
+// Hub product disabled, returning its path.
+func disabledProductConfig(t *testing.T) string {
+	t.Helper()
+	payload, err := os.ReadFile("../../installer/config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const product = "- name: Developer Hub\n      enabled: true\n"
+	if !strings.Contains(string(payload), product) {
+		t.Fatal("the Developer Hub product is not on the configuration")
+	}
+	p := filepath.Join(t.TempDir(), "config.yaml")
+	err = os.WriteFile(p, []byte(strings.Replace(string(payload), product,
+		"- name: Developer Hub\n      enabled: false\n", 1)), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// TestTopologyOutput renders the installer charts topology graph, with the
+// Developer Hub product disabled, greyed out.
+func TestTopologyOutput(t *testing.T) {
+	configPath := disabledProductConfig(t)
+	for _, format := range []string{"dot", "mermaid", "json"} {
+		t.Run(format, func(t *testing.T) {
+			app := newTestApp(t)
+			out, err := app.run(t, "topology", "--all", "--output", format,
+				"--config", configPath, "--facts", "../../test/charts/facts.yaml")
+			if err != nil {
+				t.Fatalf("topology --output %s = %v\n%s", format, err, out)
+			}
+			assertGolden(t, out,
+				filepath.Join("testdata", "topology", "graph."+format))
+		})
+	}
+
+	t.Run("json schema", func(t *testing.T) {
+		app := newTestApp(t)
+		out, err := app.run(t, "topology", "--all", "--output", "json",
+			"--config", configPath, "--facts", "../../test/charts/facts.yaml")
+		if err != nil {
+			t.Fatalf("topology --output json = %v\n%s", err, out)
+		}
+		g := topology.Graph{}
+		if err = json.Unmarshal([]byte(out), &g); err != nil {
+			t.Fatalf("decoding the graph: %v", err)
+		}
+		if g.SchemaVersion != topology.GraphSchemaVersion {
+			t.Errorf("schemaVersion = %d, want %d",
+				g.SchemaVersion, topology.GraphSchemaVersion)
+		}
+		for _, c := range g.Charts {
+			if c.Name == "tssc-dh" && (c.Enabled || c.Index != 0) {
+				t.Errorf("tssc-dh enabled, index %d, want disabled", c.Index)
+			}
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		app := newTestApp(t)
+		if _, err := app.run(t, "topology", "--output", "svg",
+			"--config", configPath, "--facts", "../../test/charts/facts.yaml"); err == nil {
+			t.Error("topology --output svg: error expected")
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package subcmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/runcontext"
+	"github.com/redhat-appstudio/tssc-cli/pkg/upgrade"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/spf13/cobra"
+)
+
+// Upgrade represents the "upgrade" subcommand, it migrates the cluster
+// configuration to the current layout and deploys the platform again.
+type Upgrade struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	manager  *config.ConfigMapManager // cluster configuration manager
+	cfg      *config.Config           // installer configuration
+	original string                   // configuration before migrations
+
+	skipDeploy bool // only migrates the configuration
+}
+
+var _ api.SubCommand = (*Upgrade)(nil)
+
+const upgradeDesc = `
+Upgrades an existing installation to the layout expected by this release.
+
+The cluster configuration is migrated to the current schema version, each
+migration transforms the previous layout while preserving comments. The
+differences are shown before the configuration is stored in the cluster.
+
+Afterwards, per-release upgrade hooks adapt the existing Helm releases to chart
+changes, like renamed releases or charts moved to another namespace. Finally the
+platform is deployed, the same as the "deploy" subcommand does.
+
+With "--dry-run" only the configuration differences are shown.
+`
+
+// Cmd exposes the cobra instance.
+func (u *Upgrade) Cmd() *cobra.Command {
+	return u.cmd
+}
+
+// log logger with contextual information.
+func (u *Upgrade) log() *slog.Logger {
+	return u.runCtx.Flags.LoggerWith(
+		u.runCtx.Logger.With("skip-deploy", u.skipDeploy))
+}
+
+// Complete loads the cluster configuration.
+func (u *Upgrade) Complete(_ []string) error {
+	u.manager = config.NewConfigMapManager(
+		u.runCtx.Kube, u.appCtx.Name, u.runCtx.Flags.Instance)
+	var err error
+	if u.cfg, err = u.manager.GetConfig(u.cmd.Context()); err != nil {
+		return err
+	}
+	u.original = u.cfg.String()
+	return nil
+}
+
+// Validate asserts the cluster configuration can be migrated.
+func (u *Upgrade) Validate() error {
+	_, err := u.cfg.PendingMigrations()
+	return err
+}
+
+// Run migrates the configuration, runs the upgrade hooks and deploys.
+func (u *Upgrade) Run() error {
+	ctx := u.cmd.Context()
+	from := u.cfg.SchemaVersion()
+
+	u.log().Debug("Migrating the cluster configuration", "schema-version", from)
+	applied, err := u.cfg.Migrate()
+	if err != nil {
+		return err
+	}
+	to := u.cfg.SchemaVersion()
+
+	if len(applied) == 0 {
+		fmt.Fprintf(u.runCtx.Out, "# Configuration is up to date (schema version %d).\n", from)
+	} else {
+		fmt.Fprintf(u.runCtx.Out, "# Migrating configuration from schema version %d to %d:\n",
+			from, to)
+		for _, m := range applied {
+			fmt.Fprintf(u.runCtx.Out, "#   - %d: %s\n", m.Version, m.Description)
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(u.original),
+			B:        difflib.SplitLines(u.cfg.String()),
+			FromFile: fmt.Sprintf("%s (schema version %d)", config.Filename, from),
+			ToFile:   fmt.Sprintf("%s (schema version %d)", config.Filename, to),
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(u.runCtx.Out, "\n%s\n", diff)
+	}
+
+	if u.runCtx.Flags.DryRun {
+		fmt.Fprintln(u.runCtx.Out, "# Dry-run mode, the cluster configuration is not modified.")
+		return nil
+	}
+
+	if len(applied) > 0 {
+		u.log().Debug("Updating the cluster configuration")
+		if err = u.manager.Update(ctx, u.cfg); err != nil {
+			return err
+		}
+		fmt.Fprintln(u.runCtx.Out, "# Cluster configuration updated.")
+	}
+
+	releases := upgrade.NewReleases(u.log(), u.runCtx.Kube, u.runCtx.Flags)
+	if err = upgrade.RunHooks(ctx, u.cfg, releases, from, to); err != nil {
+		return err
+	}
+
+	if u.skipDeploy {
+		return nil
+	}
+	u.log().Debug("Deploying the platform")
+	return runSubCommand(ctx, u.cmd, "deploy")
+}
+
+// NewUpgrade instantiates the "upgrade" subcommand.
+func NewUpgrade(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+) *Upgrade {
+	u := &Upgrade{
+		cmd: &cobra.Command{
+			Use:          "upgrade",
+			Short:        fmt.Sprintf("Upgrades an existing %s installation", appCtx.Name),
+			Long:         upgradeDesc,
+			SilenceUsage: true,
+		},
+		appCtx: appCtx,
+		runCtx: runCtx,
+	}
+	u.cmd.PersistentFlags().BoolVar(&u.skipDeploy, "skip-deploy", u.skipDeploy,
+		"Only migrate the cluster configuration, skip the deployment")
+	return u
+}
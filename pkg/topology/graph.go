@@ -0,0 +1,354 @@
+package topology
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+)
+
+// GraphSchemaVersion the version of the graph representation, incremented on
+// incompatible changes of the exported attributes.
+const GraphSchemaVersion = 1
+
+// EdgeKind the relation between the graph nodes.
+type EdgeKind string
+
+const (
+	// EdgeDependsOn the chart depends on the other chart, "depends-on".
+	EdgeDependsOn EdgeKind = "depends-on"
+	// EdgeProvides the chart provides the integration.
+	EdgeProvides EdgeKind = "provides"
+	// EdgeRequires the integration is referenced by the chart's required
+	// integrations expression.
+	EdgeRequires EdgeKind = "requires"
+)
+
+// GraphChart a chart node of the graph.
+type GraphChart struct {
+	// Index position on the topology, starting at one, zero when the chart isn't
+	// part of the installation.
+	Index                int      `json:"index"`
+	Name                 string   `json:"name"`
+	Namespace            string   `json:"namespace,omitempty"`
+	Product              string   `json:"product,omitempty"`
+	Enabled              bool     `json:"enabled"`
+	Weight               int      `json:"weight"`
+	DependsOn            []string `json:"dependsOn,omitempty"`
+	IntegrationsProvided []string `json:"integrationsProvided,omitempty"`
+	// IntegrationsRequired the CEL expression of the required integrations.
+	IntegrationsRequired string `json:"integrationsRequired,omitempty"`
+	// Condition the CEL expression of the chart enablement condition.
+	Condition string `json:"condition,omitempty"`
+	// Reasons the provenance, why the chart is installed, or was skipped.
+	Reasons []resolver.Reason `json:"reasons,omitempty"`
+}
+
+// GraphEdge a directed edge of the graph, integrations are referenced by name.
+type GraphEdge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	// Expression the CEL expression referencing the integration, on "requires".
+	Expression string `json:"expression,omitempty"`
+}
+
+// Graph the resolved topology as a graph: the charts in topology order, the
+// integrations they provide and require, and the relations between them. It's
+// the stable representation exported by "topology --output", charts not part of
+// the installation are only present when requested, disabled.
+type Graph struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	Charts        []GraphChart `json:"charts"`
+	Integrations  []string     `json:"integrations"`
+	Edges         []GraphEdge  `json:"edges"`
+
+	collection *resolver.Collection // charts collection
+	topology   *resolver.Topology   // resolved topology, with the provenance
+}
+
+// WriteExplain writes the provenance chain of the named chart, recorded by the
+// framework's resolver: why it's installed, or skipped, down to the products.
+func (g *Graph) WriteExplain(w io.Writer, name string) error {
+	return g.topology.Explain(w, g.collection, name)
+}
+
+// chart returns the named chart node, nil when not in the graph.
+func (g *Graph) chart(name string) *GraphChart {
+	for i := range g.Charts {
+		if g.Charts[i].Name == name {
+			return &g.Charts[i]
+		}
+	}
+	return nil
+}
+
+// WriteTable writes the graph charts as a table, the same columns of the
+// resolver's table, charts not part of the installation are not indexed.
+func (g *Graph) WriteTable(w io.Writer) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	row := func(a ...any) {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", a...)
+	}
+	row("Index", "Dependency", "Namespace", "Product", "Depends-On", "Weight",
+		"Provided-Integrations", "Required-Integrations")
+	for _, c := range g.Charts {
+		index := " -"
+		if c.Enabled {
+			index = fmt.Sprintf("%2d", c.Index)
+		}
+		row(
+			index,
+			c.Name,
+			c.Namespace,
+			c.Product,
+			strings.Join(c.DependsOn, ", "),
+			fmt.Sprintf("%d", c.Weight),
+			strings.Join(c.IntegrationsProvided, ", "),
+			c.IntegrationsRequired,
+		)
+	}
+	return table.Flush()
+}
+
+// dotQuote quotes the DOT identifier, or the label lines.
+func dotQuote(lines ...string) string {
+	for i, l := range lines {
+		l = strings.ReplaceAll(l, `\`, `\\`)
+		lines[i] = strings.ReplaceAll(l, `"`, `\"`)
+	}
+	return `"` + strings.Join(lines, `\n`) + `"`
+}
+
+// integrationNode the node identifier of the integration, apart from charts.
+func integrationNode(name string) string {
+	return "integration/" + name
+}
+
+// label returns the chart node label lines: name, product, namespace, required
+// integrations and condition.
+func (c *GraphChart) label() []string {
+	lines := []string{c.Name}
+	if c.Product != "" {
+		lines = append(lines, "product: "+c.Product)
+	}
+	if c.Namespace != "" {
+		lines = append(lines, "namespace: "+c.Namespace)
+	}
+	if c.IntegrationsRequired != "" {
+		lines = append(lines, "requires: "+c.IntegrationsRequired)
+	}
+	if c.Condition != "" {
+		lines = append(lines, "when: "+c.Condition)
+	}
+	return lines
+}
+
+// WriteDOT writes the graph in Graphviz DOT format, charts not part of the
+// installation are greyed out.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded, fontname=Helvetica];\n")
+	b.WriteString("  edge [fontname=Helvetica, fontsize=10];\n")
+	for _, c := range g.Charts {
+		attrs := "label=" + dotQuote(c.label()...)
+		switch {
+		case !c.Enabled:
+			attrs += `, style="rounded,dashed", color=gray, fontcolor=gray`
+		case c.Product != "":
+			attrs += `, style="rounded,bold"`
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(c.Name), attrs)
+	}
+	for _, name := range g.Integrations {
+		fmt.Fprintf(&b, "  %s [label=%s, shape=ellipse];\n",
+			dotQuote(integrationNode(name)), dotQuote(name))
+	}
+	for _, e := range g.Edges {
+		from, to, attrs := e.From, e.To, "label="+dotQuote(string(e.Kind))
+		switch e.Kind {
+		case EdgeProvides:
+			to = integrationNode(e.To)
+			attrs += ", style=dashed"
+		case EdgeRequires:
+			from = integrationNode(e.From)
+			attrs += ", style=dashed"
+		}
+		if c := g.chart(e.From); c != nil && !c.Enabled {
+			attrs += ", color=gray, fontcolor=gray"
+		} else if c := g.chart(e.To); c != nil && !c.Enabled {
+			attrs += ", color=gray, fontcolor=gray"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(from), dotQuote(to), attrs)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidIDRe matches the characters not allowed on Mermaid node identifiers.
+var mermaidIDRe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// mermaidID returns the Mermaid node identifier for the graph node.
+func mermaidID(name string) string {
+	return mermaidIDRe.ReplaceAllString(name, "_")
+}
+
+// mermaidQuote quotes the Mermaid label lines.
+func mermaidQuote(lines ...string) string {
+	for i, l := range lines {
+		l = strings.ReplaceAll(l, `"`, "#quot;")
+		l = strings.ReplaceAll(l, "<", "#lt;")
+		lines[i] = strings.ReplaceAll(l, ">", "#gt;")
+	}
+	return `"` + strings.Join(lines, "<br/>") + `"`
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart, charts not part of the
+// installation are greyed out.
+func (g *Graph) WriteMermaid(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	disabled := []string{}
+	for _, c := range g.Charts {
+		fmt.Fprintf(&b, "  %s[%s]\n", mermaidID(c.Name), mermaidQuote(c.label()...))
+		if !c.Enabled {
+			disabled = append(disabled, mermaidID(c.Name))
+		}
+	}
+	for _, name := range g.Integrations {
+		fmt.Fprintf(&b, "  %s([%s])\n",
+			mermaidID(integrationNode(name)), mermaidQuote(name))
+	}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case EdgeProvides:
+			fmt.Fprintf(&b, "  %s -.->|provides| %s\n",
+				mermaidID(e.From), mermaidID(integrationNode(e.To)))
+		case EdgeRequires:
+			fmt.Fprintf(&b, "  %s -.->|requires| %s\n",
+				mermaidID(integrationNode(e.From)), mermaidID(e.To))
+		default:
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n",
+				mermaidID(e.From), e.Kind, mermaidID(e.To))
+		}
+	}
+	if len(disabled) > 0 {
+		b.WriteString("  classDef disabled fill:#eeeeee,stroke:#999999,color:#999999,stroke-dasharray:4\n")
+		fmt.Fprintf(&b, "  class %s disabled\n", strings.Join(disabled, ","))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// newGraphChart instantiates the chart node for the dependency.
+func newGraphChart(
+	t *resolver.Topology,
+	index int,
+	d *resolver.Dependency,
+	enabled bool,
+) GraphChart {
+	weight, _ := d.Weight()
+	return GraphChart{
+		Index:                index,
+		Name:                 d.Name(),
+		Namespace:            d.Namespace(),
+		Product:              d.ProductName(),
+		Enabled:              enabled,
+		Weight:               weight,
+		DependsOn:            d.DependsOn(),
+		IntegrationsProvided: d.IntegrationsProvided(),
+		IntegrationsRequired: d.IntegrationsRequired(),
+		Condition:            d.Condition(),
+		Reasons:              t.Reasons(d.Name()),
+	}
+}
+
+// NewGraph builds the graph of the resolved topology. With all, the collection
+// charts not part of the installation, as the charts of disabled products, are
+// added disabled after the topology charts, sorted by name. The configured
+// integrations, keyed by every integration registered, declare the names the
+// chart conditions refer to.
+func NewGraph(
+	cfg *config.Config,
+	collection *resolver.Collection,
+	t *resolver.Topology,
+	configured map[string]bool,
+	all bool,
+) (*Graph, error) {
+	g := &Graph{
+		SchemaVersion: GraphSchemaVersion,
+		Charts:        []GraphChart{},
+		Integrations:  []string{},
+		Edges:         []GraphEdge{},
+		collection:    collection,
+		topology:      t,
+	}
+	for i, d := range t.Dependencies() {
+		g.Charts = append(g.Charts, newGraphChart(t, i+1, &d, true))
+	}
+	err := collection.Walk(func(name string, d resolver.Dependency) error {
+		if !all || t.Contains(name) {
+			return nil
+		}
+		// The namespace is informative, products not configured have none.
+		if namespace, err := ChartNamespace(cfg, d.Chart()); err == nil {
+			d.SetNamespace(namespace)
+		}
+		g.Charts = append(g.Charts, newGraphChart(t, 0, &d, false))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cel, err := newCEL(configured)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range g.Charts {
+		for _, dependsOn := range c.DependsOn {
+			if g.chart(dependsOn) != nil {
+				g.Edges = append(g.Edges, GraphEdge{
+					From: c.Name, To: dependsOn, Kind: EdgeDependsOn,
+				})
+			}
+		}
+	}
+	for _, c := range g.Charts {
+		for _, name := range c.IntegrationsProvided {
+			g.Edges = append(g.Edges, GraphEdge{
+				From: c.Name, To: name, Kind: EdgeProvides,
+			})
+			g.Integrations = append(g.Integrations, name)
+		}
+	}
+	for _, c := range g.Charts {
+		if c.IntegrationsRequired == "" {
+			continue
+		}
+		referenced, err := cel.Referenced(c.IntegrationsRequired)
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", c.Name, err)
+		}
+		for _, name := range referenced {
+			g.Edges = append(g.Edges, GraphEdge{
+				From:       name,
+				To:         c.Name,
+				Kind:       EdgeRequires,
+				Expression: c.IntegrationsRequired,
+			})
+			g.Integrations = append(g.Integrations, name)
+		}
+	}
+	slices.Sort(g.Integrations)
+	g.Integrations = slices.Compact(g.Integrations)
+	return g, nil
+}
@@ -0,0 +1,129 @@
+// Package topology resolves the installer charts with the framework's resolver,
+// the charts "deploy" installs, in the same order, for the subcommands rendering
+// and inspecting the installation, and exports the topology as a graph.
+package topology
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/annotations"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/api/resolver"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+// ChartNamespace returns the namespace for the chart, the installer's namespace,
+// or the namespace of the product associated with it.
+func ChartNamespace(cfg *config.Config, hc *chart.Chart) (string, error) {
+	product := hc.Metadata.Annotations[annotations.UseProductNamespace]
+	// The associated product takes precedence over "use-product-namespace".
+	if p := hc.Metadata.Annotations[annotations.ProductName]; p != "" {
+		product = p
+	}
+	if product == "" {
+		return cfg.Namespace(), nil
+	}
+	spec, err := cfg.GetProduct(product)
+	if err != nil {
+		return "", err
+	}
+	return spec.GetNamespace(), nil
+}
+
+// newCEL returns the CEL environment declaring the integrations, every integration
+// registered is informed on the configured map.
+func newCEL(configured map[string]bool) (*resolver.CEL, error) {
+	return resolver.NewCEL(slices.Sorted(maps.Keys(configured))...)
+}
+
+// Resolve resolves the topology of the collection for the configuration, the
+// chart conditions are evaluated against the configured integrations.
+func Resolve(
+	cfg *config.Config,
+	collection *resolver.Collection,
+	configured map[string]bool,
+) (*resolver.Topology, error) {
+	cel, err := newCEL(configured)
+	if err != nil {
+		return nil, err
+	}
+	t := resolver.NewTopology()
+	r := resolver.NewResolver(cfg, collection, t)
+	r.SetIntegrations(cel, configured)
+	if err = r.Resolve(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ResolveCharts resolves the topology of the informed charts for the
+// configuration and the configured integrations.
+func ResolveCharts(
+	appCtx *api.AppContext,
+	cfg *config.Config,
+	charts []chart.Chart,
+	configured map[string]bool,
+) (*resolver.Topology, error) {
+	collection, err := resolver.NewCollection(appCtx, charts)
+	if err != nil {
+		return nil, err
+	}
+	return Resolve(cfg, collection, configured)
+}
+
+// Requirements computes the integrations the resolved topology requires, besides
+// the integrations its charts provide.
+func Requirements(
+	cfg *config.Config,
+	t *resolver.Topology,
+	configured map[string]bool,
+) (*resolver.Requirements, error) {
+	cel, err := newCEL(configured)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.NewRequirements(cfg, t, cel, configured)
+}
+
+// ProductSubset returns the topology of the informed products charts, and the
+// charts they depend on, recursively, keeping the resolved order and namespaces.
+func ProductSubset(
+	t *resolver.Topology,
+	products ...string,
+) (*resolver.Topology, error) {
+	selected := map[string]bool{}
+	var selectFn func(name string)
+	selectFn = func(name string) {
+		d, err := t.GetDependency(name)
+		if err != nil || selected[name] {
+			return
+		}
+		selected[name] = true
+		for _, dependsOn := range d.DependsOn() {
+			selectFn(dependsOn)
+		}
+	}
+	for _, product := range products {
+		found := false
+		for _, d := range t.Dependencies() {
+			if d.ProductName() == product {
+				selectFn(d.Name())
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: for product %s, is it enabled?",
+				resolver.ErrDependencyNotFound, product)
+		}
+	}
+	subset := resolver.NewTopology()
+	for _, d := range t.Dependencies() {
+		if selected[d.Name()] {
+			subset.Append(d)
+		}
+	}
+	return subset, nil
+}
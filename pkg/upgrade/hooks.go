@@ -0,0 +1,75 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/redhat-appstudio/helmet/api/config"
+)
+
+// HookFn adapts the cluster to the chart changes of a release, the hook runs
+// after the configuration is migrated and before the deployment.
+type HookFn func(ctx context.Context, cfg *config.Config, releases *Releases) error
+
+// Hook per-release upgrade hook, bound to the configuration schema version the
+// release introduced.
+type Hook struct {
+	Version     int    // configuration schema version
+	Description string // short description of the changes
+	Run         HookFn // hook function
+}
+
+// hooks registry of upgrade hooks, sorted by version.
+var hooks = []Hook{}
+
+// Register adds a new upgrade hook to the registry, sorted by version. Hooks are
+// the place to handle chart changes the Helm upgrade can't, for instance:
+//
+//	upgrade.Register(upgrade.Hook{
+//		Version:     3,
+//		Description: "tssc-dh chart renamed to tssc-developer-hub",
+//		Run: func(_ context.Context, cfg *config.Config, r *upgrade.Releases) error {
+//			p, err := cfg.GetProduct("Developer Hub")
+//			if err != nil {
+//				return err
+//			}
+//			return r.Rename(p.GetNamespace(), "tssc-dh", "tssc-developer-hub")
+//		},
+//	})
+func Register(h Hook) {
+	hooks = append(hooks, h)
+	slices.SortStableFunc(hooks, func(a, b Hook) int {
+		return a.Version - b.Version
+	})
+}
+
+// Hooks returns the hooks for the schema versions after "from" up to "to".
+func Hooks(from, to int) []Hook {
+	selected := []Hook{}
+	for _, h := range hooks {
+		if h.Version > from && h.Version <= to {
+			selected = append(selected, h)
+		}
+	}
+	return selected
+}
+
+// RunHooks runs the hooks for the schema versions after "from" up to "to", in
+// order, stopping on the first error.
+func RunHooks(
+	ctx context.Context,
+	cfg *config.Config,
+	releases *Releases,
+	from, to int,
+) error {
+	for _, h := range Hooks(from, to) {
+		releases.logger.Info("Running upgrade hook",
+			"version", h.Version, "description", h.Description)
+		if err := h.Run(ctx, cfg, releases); err != nil {
+			return fmt.Errorf("upgrade hook for version %d (%s): %w",
+				h.Version, h.Description, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package upgrade
+
+import (
+	"github.com/redhat-appstudio/helmet/api/config"
+	"gopkg.in/yaml.v3"
+)
+
+// developerHubProduct name of the Developer Hub product in the configuration.
+const developerHubProduct = "Developer Hub"
+
+// migrateV2 brings release-1.8 configurations to the release-1.9 layout. The
+// Developer Hub "authProvider" property became mandatory, release-1.8 always
+// authenticated users with GitHub, so that's what older configurations get.
+func migrateV2(appNode *yaml.Node) error {
+	products := config.MappingValue(appNode, "products")
+	if products == nil || products.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for _, product := range products.Content {
+		name := config.MappingValue(product, "name")
+		if name == nil || name.Value != developerHubProduct {
+			continue
+		}
+		properties := config.MappingValue(product, "properties")
+		if properties == nil || properties.Kind != yaml.MappingNode {
+			properties = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			config.SetMappingValue(product, "properties", properties, "")
+		}
+		if config.MappingValue(properties, "authProvider") != nil {
+			continue
+		}
+		config.SetMappingValue(
+			properties,
+			"authProvider",
+			config.ScalarNode("!!str", "github"),
+			"Possible values: github, gitlab, oidc",
+		)
+	}
+	return nil
+}
+
+func init() {
+	config.RegisterMigration(config.Migration{
+		Version:     2,
+		Description: "Developer Hub requires the \"authProvider\" property",
+		Migrate:     migrateV2,
+	})
+}
@@ -0,0 +1,114 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api/config"
+	"gopkg.in/yaml.v3"
+)
+
+// authProvider returns the Developer Hub "authProvider" property of the
+// migrated application node, and whether it's set.
+func authProvider(t *testing.T, appNode *yaml.Node) (string, bool) {
+	t.Helper()
+	products := config.MappingValue(appNode, "products")
+	if products == nil {
+		return "", false
+	}
+	for _, product := range products.Content {
+		name := config.MappingValue(product, "name")
+		if name == nil || name.Value != developerHubProduct {
+			continue
+		}
+		v := config.MappingValue(
+			config.MappingValue(product, "properties"), "authProvider")
+		if v == nil {
+			return "", false
+		}
+		return v.Value, true
+	}
+	return "", false
+}
+
+func TestMigrateV2(t *testing.T) {
+	tests := []struct {
+		name   string // test case name
+		app    string // application configuration, release-1.8
+		want   string // expected "authProvider"
+		wantOK bool   // whether "authProvider" is expected
+	}{{
+		name: "authentication provider defaults to github",
+		app: `
+products:
+  - name: Developer Hub
+    enabled: true
+    properties:
+      catalogURL: https://example.com/catalog.yaml
+`,
+		want:   "github",
+		wantOK: true,
+	}, {
+		name: "properties are added",
+		app: `
+products:
+  - name: Developer Hub
+    enabled: true
+`,
+		want:   "github",
+		wantOK: true,
+	}, {
+		name: "authentication provider is kept",
+		app: `
+products:
+  - name: Developer Hub
+    properties:
+      authProvider: gitlab
+`,
+		want:   "gitlab",
+		wantOK: true,
+	}, {
+		name: "Developer Hub not configured",
+		app: `
+products:
+  - name: Quay
+    properties: {}
+`,
+	}, {
+		name: "products not configured",
+		app:  "settings: {}\n",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc yaml.Node
+			if err := yaml.Unmarshal([]byte(tt.app), &doc); err != nil {
+				t.Fatalf("parsing the configuration: %v", err)
+			}
+			appNode := doc.Content[0]
+			if err := migrateV2(appNode); err != nil {
+				t.Fatalf("migrateV2() = %v", err)
+			}
+			got, ok := authProvider(t, appNode)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("authProvider = %q (set %v), want %q (set %v)",
+					got, ok, tt.want, tt.wantOK)
+			}
+
+			// Migrating again changes nothing.
+			before, err := yaml.Marshal(appNode)
+			if err != nil {
+				t.Fatalf("marshaling the configuration: %v", err)
+			}
+			if err = migrateV2(appNode); err != nil {
+				t.Fatalf("migrateV2() = %v", err)
+			}
+			after, err := yaml.Marshal(appNode)
+			if err != nil {
+				t.Fatalf("marshaling the configuration: %v", err)
+			}
+			if string(before) != string(after) {
+				t.Errorf("migrating twice:\n got: %s\nwant: %s", after, before)
+			}
+		})
+	}
+}
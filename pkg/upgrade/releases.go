@@ -0,0 +1,133 @@
+package upgrade
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/k8s"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/resource"
+)
+
+// Releases offers the Helm release operations upgrade hooks rely on to adapt
+// existing installations to chart changes.
+type Releases struct {
+	logger *slog.Logger  // application logger
+	kube   k8s.Interface // kubernetes client
+	flags  *api.Flags    // global flags, the installation instance
+}
+
+const (
+	// releaseNameAnnotation Helm ownership annotation, release name.
+	releaseNameAnnotation = "meta.helm.sh/release-name"
+	// releaseNamespaceAnnotation Helm ownership annotation, release namespace.
+	releaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+)
+
+// actionConfig returns the Helm action configuration for the namespace.
+func (r *Releases) actionConfig(namespace string) (*action.Configuration, error) {
+	return k8s.NewHelmActionConfig(r.logger, r.kube, namespace)
+}
+
+// Exists checks whether the Helm release exists on the namespace. Release names
+// are chart names, scoped by the installation instance on every operation.
+func (r *Releases) Exists(namespace, name string) (bool, error) {
+	name = r.flags.Scoped(name)
+	actionCfg, err := r.actionConfig(namespace)
+	if err != nil {
+		return false, err
+	}
+	if _, err = action.NewGet(actionCfg).Run(name); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Rename hands over the resources of release "from" to release "to", on the
+// same namespace. The Helm ownership annotations are rewritten on every release
+// resource and the old release history is removed, without deleting the
+// resources. The next deployment of "to" adopts the existing resources.
+func (r *Releases) Rename(namespace, from, to string) error {
+	from, to = r.flags.Scoped(from), r.flags.Scoped(to)
+	logger := r.logger.With("namespace", namespace, "from", from, "to", to)
+	actionCfg, err := r.actionConfig(namespace)
+	if err != nil {
+		return err
+	}
+	rel, err := action.NewGet(actionCfg).Run(from)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			logger.Debug("Release not found, nothing to rename")
+			return nil
+		}
+		return err
+	}
+
+	logger.Info("Transferring release resources ownership...")
+	resources, err := actionCfg.KubeClient.Build(
+		bytes.NewBufferString(rel.Manifest), false)
+	if err != nil {
+		return err
+	}
+	patch := fmt.Appendf(nil, `{"metadata":{"annotations":{%q:%q,%q:%q}}}`,
+		releaseNameAnnotation, to, releaseNamespaceAnnotation, namespace)
+	if err = resources.Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		logger.Debug("Patching resource ownership",
+			"kind", info.Mapping.GroupVersionKind.Kind, "name", info.Name)
+		_, err = resource.NewHelper(info.Client, info.Mapping).
+			Patch(info.Namespace, info.Name, types.MergePatchType, patch, nil)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	logger.Info("Removing the previous release history...")
+	history, err := actionCfg.Releases.History(from)
+	if err != nil {
+		return err
+	}
+	for _, h := range history {
+		if _, err = actionCfg.Releases.Delete(h.Name, h.Version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Uninstall removes the release and its resources. Used when a chart moves to
+// another namespace, namespaced resources cannot be transferred and the release
+// is recreated by the next deployment.
+func (r *Releases) Uninstall(namespace, name string) error {
+	exists, err := r.Exists(namespace, name)
+	if err != nil || !exists {
+		return err
+	}
+	name = r.flags.Scoped(name)
+	actionCfg, err := r.actionConfig(namespace)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("Uninstalling release...", "namespace", namespace, "name", name)
+	_, err = action.NewUninstall(actionCfg).Run(name)
+	return err
+}
+
+// NewReleases instantiates the Helm release operations.
+func NewReleases(
+	logger *slog.Logger,
+	kube k8s.Interface,
+	f *api.Flags,
+) *Releases {
+	return &Releases{logger: logger, kube: kube, flags: f}
+}
@@ -0,0 +1,286 @@
+package verify
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/githubapp"
+)
+
+// dockerConfig the registry credentials, ".dockerconfigjson" format.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+}
+
+// bearer sets the bearer token authorization.
+func bearer(token string) func(*http.Request) {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// basic sets the basic authorization.
+func basic(username, password string) func(*http.Request) {
+	return func(r *http.Request) {
+		r.SetBasicAuth(username, password)
+	}
+}
+
+// registryURL returns the registry API base URL, registries are informed by
+// hostname or URL on the docker configuration.
+func registryURL(registry string) string {
+	if u, err := url.Parse(registry); err == nil && u.Host != "" {
+		return fmt.Sprintf("%s://%s/v2/", u.Scheme, u.Host)
+	}
+	return fmt.Sprintf("https://%s/v2/", strings.TrimSuffix(registry, "/"))
+}
+
+// challengeParam matches the parameters of the "WWW-Authenticate" challenge.
+var challengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// bearerChallenge returns the token endpoint of the registry "Bearer" challenge,
+// and whether the registry issued one.
+func bearerChallenge(header string) (string, bool) {
+	scheme, params, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	values := map[string]string{}
+	for _, m := range challengeParam.FindAllStringSubmatch(params, -1) {
+		values[m[1]] = m[2]
+	}
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Host == "" {
+		return "", false
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if values[key] != "" {
+			query.Set(key, values[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+	return realm.String(), true
+}
+
+// registryLogin verifies the credentials with the registry API. Registries
+// issuing a "Bearer" challenge are followed: the credentials are exchanged for
+// a token on the challenge realm, the registry API must accept the token.
+func registryLogin(
+	ctx context.Context,
+	c *Client,
+	registry, username, password string,
+) error {
+	endpoint := registryURL(registry)
+	res, _, err := c.do(ctx, endpoint, basic(username, password))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusUnauthorized {
+		return successful(endpoint, res)
+	}
+	tokenURL, ok := bearerChallenge(res.Header.Get("WWW-Authenticate"))
+	if !ok {
+		return successful(endpoint, res)
+	}
+	body, err := c.Get(ctx, tokenURL, basic(username, password))
+	if err != nil {
+		return err
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err = json.Unmarshal(body, &token); err != nil {
+		return fmt.Errorf("%w: token response: %w", ErrVerificationFailed, err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return fmt.Errorf("%w: token response without a token",
+			ErrVerificationFailed)
+	}
+	_, err = c.Get(ctx, endpoint, bearer(token.Token))
+	return err
+}
+
+// dockerConfigAuths verifies each registry credential with the registry API.
+func dockerConfigAuths(ctx context.Context, c *Client, payload []byte) error {
+	cfg := dockerConfig{}
+	if err := json.Unmarshal(payload, &cfg); err != nil {
+		return fmt.Errorf("%w: invalid docker configuration: %w",
+			ErrVerificationFailed, err)
+	}
+	for registry, auth := range cfg.Auths {
+		username, password := auth.Username, auth.Password
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return fmt.Errorf("%w: registry %q: invalid auth: %w",
+					ErrVerificationFailed, registry, err)
+			}
+			username, password, _ = strings.Cut(string(decoded), ":")
+		}
+		if err := registryLogin(ctx, c, registry, username, password); err != nil {
+			return fmt.Errorf("registry %q: %w", registry, err)
+		}
+	}
+	return nil
+}
+
+// containerRegistry verifies the registry credentials, read-write and
+// read-only, with the registry API.
+func containerRegistry(ctx context.Context, c *Client, data map[string][]byte) error {
+	if len(data[".dockerconfigjson"]) == 0 {
+		return fmt.Errorf("%w: [.dockerconfigjson]", ErrMissingData)
+	}
+	for _, key := range []string{".dockerconfigjson", ".dockerconfigjsonreadonly"} {
+		if len(data[key]) == 0 {
+			continue
+		}
+		if err := dockerConfigAuths(ctx, c, data[key]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// quay verifies the Quay API token, on the organization when informed, and the
+// registry credentials.
+func quay(ctx context.Context, c *Client, data map[string][]byte) error {
+	token := string(data["token"])
+	if token == "" {
+		return containerRegistry(ctx, c, data)
+	}
+	values, err := required(data, "url")
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimSuffix(values[0], "/") + "/api/v1/user/"
+	if org := string(data["organization"]); org != "" {
+		endpoint = fmt.Sprintf("%s/api/v1/organization/%s",
+			strings.TrimSuffix(values[0], "/"), url.PathEscape(org))
+	}
+	if _, err = c.Get(ctx, endpoint, bearer(token)); err != nil {
+		return err
+	}
+	if len(data[".dockerconfigjson"]) == 0 {
+		return nil
+	}
+	return containerRegistry(ctx, c, data)
+}
+
+// acs verifies the ACS Central is reachable and accepts the API token.
+func acs(ctx context.Context, c *Client, data map[string][]byte) error {
+	values, err := required(data, "endpoint", "token")
+	if err != nil {
+		return err
+	}
+	base := "https://" + values[0]
+	if _, err = c.Get(ctx, base+"/v1/ping", nil); err != nil {
+		return err
+	}
+	_, err = c.Get(ctx, base+"/v1/auth/status", bearer(values[1]))
+	return err
+}
+
+// jenkins verifies the Jenkins user token, anonymous access isn't enough.
+func jenkins(ctx context.Context, c *Client, data map[string][]byte) error {
+	values, err := required(data, "baseUrl", "username", "token")
+	if err != nil {
+		return err
+	}
+	body, err := c.Get(ctx,
+		strings.TrimSuffix(values[0], "/")+"/whoAmI/api/json",
+		basic(values[1], values[2]))
+	if err != nil {
+		return err
+	}
+	whoAmI := struct {
+		Name          string `json:"name"`
+		Anonymous     bool   `json:"anonymous"`
+		Authenticated bool   `json:"authenticated"`
+	}{}
+	if err = json.Unmarshal(body, &whoAmI); err != nil {
+		return fmt.Errorf("%w: whoAmI: %w", ErrVerificationFailed, err)
+	}
+	if whoAmI.Anonymous || !whoAmI.Authenticated || whoAmI.Name != values[1] {
+		return fmt.Errorf("%w: jenkins authenticated as %q, expected %q",
+			ErrVerificationFailed, whoAmI.Name, values[1])
+	}
+	return nil
+}
+
+// bitbucket verifies the Bitbucket app password with the user endpoint.
+func bitbucket(ctx context.Context, c *Client, data map[string][]byte) error {
+	values, err := required(data, "host", "username", "appPassword")
+	if err != nil {
+		return err
+	}
+	_, err = c.Get(ctx, fmt.Sprintf("https://api.%s/2.0/user", values[0]),
+		basic(values[1], values[2]))
+	return err
+}
+
+// gitLab verifies the GitLab token with the user endpoint.
+func gitLab(ctx context.Context, c *Client, data map[string][]byte) error {
+	values, err := required(data, "host", "token")
+	if err != nil {
+		return err
+	}
+	host := values[0]
+	if port := string(data["port"]); port != "" && port != "443" {
+		host = fmt.Sprintf("%s:%s", host, port)
+	}
+	_, err = c.Get(ctx, fmt.Sprintf("https://%s/api/v4/user", host),
+		func(r *http.Request) { r.Header.Set("PRIVATE-TOKEN", values[1]) })
+	return err
+}
+
+// gitHub verifies the GitHub App private key, authenticating as the app.
+func gitHub(ctx context.Context, c *Client, data map[string][]byte) error {
+	creds, err := githubapp.CredentialsFromSecret(data)
+	if err != nil {
+		return err
+	}
+	key, err := githubapp.ParsePrivateKey(creds.PrivateKey)
+	if err != nil {
+		return err
+	}
+	token, err := githubapp.SignJWT(creds.AppID, key, time.Now())
+	if err != nil {
+		return err
+	}
+	apiURL := "https://api.github.com/app"
+	if host := string(data["host"]); host != "" && host != "github.com" {
+		apiURL = fmt.Sprintf("https://%s/api/v3/app", host)
+	}
+	_, err = c.Get(ctx, apiURL, bearer(token))
+	return err
+}
+
+// trustedArtifactSigner verifies the Rekor transparency log and the TUF
+// repository are reachable.
+func trustedArtifactSigner(ctx context.Context, c *Client, data map[string][]byte) error {
+	values, err := required(data, "rekor_url", "tuf_url")
+	if err != nil {
+		return err
+	}
+	if _, err = c.Get(ctx, strings.TrimSuffix(values[0], "/")+"/api/v1/log", nil); err != nil {
+		return err
+	}
+	_, err = c.Get(ctx, strings.TrimSuffix(values[1], "/")+"/root.json", nil)
+	return err
+}
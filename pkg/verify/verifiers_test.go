@@ -0,0 +1,392 @@
+package verify
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/tssc-cli/pkg/githubapp"
+)
+
+// hasBasic returns whether the request carries the basic credentials.
+func hasBasic(username, password string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		u, p, ok := r.BasicAuth()
+		return ok && u == username && p == password
+	}
+}
+
+// hasBearer returns whether the request carries the bearer token.
+func hasBearer(token string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer "+token
+	}
+}
+
+// dockerConfigJSON returns a docker configuration with the registry auth.
+func dockerConfigJSON(registry, username, password string) []byte {
+	auth := base64.StdEncoding.EncodeToString(
+		[]byte(username + ":" + password))
+	return []byte(fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, registry, auth))
+}
+
+// registryChallenge the "Bearer" challenge issued by the fake registry.
+const registryChallenge = `Bearer realm="https://auth.example.com/token",` +
+	`service="registry.example.com"`
+
+// registryTokenAuth returns whether the request is authorized by the fake
+// registry: the token endpoint takes the basic credentials for the service,
+// the registry API takes the token issued.
+func registryTokenAuth(username, password string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		switch r.URL.Path {
+		case "/token":
+			return hasBasic(username, password)(r) &&
+				r.URL.Query().Get("service") == "registry.example.com"
+		case "/v2/":
+			return hasBearer("registry-token")(r)
+		}
+		return false
+	}
+}
+
+func TestContainerRegistry(t *testing.T) {
+	testVerifier(t, containerRegistry, []verifierCase{{
+		name: "read-write and read-only credentials",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "secret"),
+			".dockerconfigjsonreadonly": dockerConfigJSON(
+				"https://registry.example.com:5000", "reader", "secret"),
+		},
+		auth: func(r *http.Request) bool {
+			return hasBasic("user", "secret")(r) ||
+				hasBasic("reader", "secret")(r)
+		},
+		requests: []string{
+			"registry.example.com/v2/",
+			"registry.example.com:5000/v2/",
+		},
+	}, {
+		name: "rejected credentials",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "wrong"),
+		},
+		auth:     hasBasic("user", "secret"),
+		requests: []string{"registry.example.com/v2/"},
+		err:      ErrVerificationFailed,
+	}, {
+		name: "bearer token challenge",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "secret"),
+		},
+		auth:      registryTokenAuth("user", "secret"),
+		challenge: registryChallenge,
+		body:      `{"token":"registry-token"}`,
+		requests: []string{
+			"registry.example.com/v2/",
+			"auth.example.com/token",
+			"registry.example.com/v2/",
+		},
+	}, {
+		name: "bearer token challenge, access token",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "secret"),
+		},
+		auth:      registryTokenAuth("user", "secret"),
+		challenge: registryChallenge,
+		body:      `{"access_token":"registry-token"}`,
+		requests: []string{
+			"registry.example.com/v2/",
+			"auth.example.com/token",
+			"registry.example.com/v2/",
+		},
+	}, {
+		name: "bearer token challenge, rejected credentials",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "wrong"),
+		},
+		auth:      registryTokenAuth("user", "secret"),
+		challenge: registryChallenge,
+		body:      `{"token":"registry-token"}`,
+		requests: []string{
+			"registry.example.com/v2/",
+			"auth.example.com/token",
+		},
+		err: ErrVerificationFailed,
+	}, {
+		name: "bearer token challenge, token without access",
+		data: map[string][]byte{
+			".dockerconfigjson": dockerConfigJSON(
+				"registry.example.com", "user", "secret"),
+		},
+		auth:      registryTokenAuth("user", "secret"),
+		challenge: registryChallenge,
+		body:      `{"token":"anonymous"}`,
+		requests: []string{
+			"registry.example.com/v2/",
+			"auth.example.com/token",
+			"registry.example.com/v2/",
+		},
+		err: ErrVerificationFailed,
+	}, {
+		name:     "invalid docker configuration",
+		data:     map[string][]byte{".dockerconfigjson": []byte("{")},
+		requests: []string{},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "missing docker configuration",
+		data:     map[string][]byte{},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestQuay(t *testing.T) {
+	testVerifier(t, quay, []verifierCase{{
+		name: "organization token and registry credentials",
+		data: map[string][]byte{
+			"token":             []byte("token"),
+			"url":               []byte("https://quay.example.com/"),
+			"organization":      []byte("tssc"),
+			".dockerconfigjson": dockerConfigJSON("quay.example.com", "u", "p"),
+		},
+		auth: func(r *http.Request) bool {
+			return hasBearer("token")(r) || hasBasic("u", "p")(r)
+		},
+		requests: []string{
+			"quay.example.com/api/v1/organization/tssc",
+			"quay.example.com/v2/",
+		},
+	}, {
+		name: "user token",
+		data: map[string][]byte{
+			"token": []byte("token"),
+			"url":   []byte("https://quay.example.com"),
+		},
+		auth:     hasBearer("token"),
+		requests: []string{"quay.example.com/api/v1/user/"},
+	}, {
+		name: "rejected token",
+		data: map[string][]byte{
+			"token": []byte("wrong"),
+			"url":   []byte("https://quay.example.com"),
+		},
+		auth:     hasBearer("token"),
+		requests: []string{"quay.example.com/api/v1/user/"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "token without url",
+		data:     map[string][]byte{"token": []byte("token")},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestACS(t *testing.T) {
+	auth := func(r *http.Request) bool {
+		return r.URL.Path == "/v1/ping" || hasBearer("token")(r)
+	}
+	testVerifier(t, acs, []verifierCase{{
+		name: "valid token",
+		data: map[string][]byte{
+			"endpoint": []byte("central.example.com:443"),
+			"token":    []byte("token"),
+		},
+		auth: auth,
+		requests: []string{
+			"central.example.com:443/v1/ping",
+			"central.example.com:443/v1/auth/status",
+		},
+	}, {
+		name: "rejected token",
+		data: map[string][]byte{
+			"endpoint": []byte("central.example.com:443"),
+			"token":    []byte("wrong"),
+		},
+		auth: auth,
+		requests: []string{
+			"central.example.com:443/v1/ping",
+			"central.example.com:443/v1/auth/status",
+		},
+		err: ErrVerificationFailed,
+	}, {
+		name:     "missing token",
+		data:     map[string][]byte{"endpoint": []byte("central.example.com")},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestJenkins(t *testing.T) {
+	data := map[string][]byte{
+		"baseUrl":  []byte("https://jenkins.example.com/"),
+		"username": []byte("admin"),
+		"token":    []byte("token"),
+	}
+	testVerifier(t, jenkins, []verifierCase{{
+		name:     "authenticated user",
+		data:     data,
+		auth:     hasBasic("admin", "token"),
+		body:     `{"name":"admin","anonymous":false,"authenticated":true}`,
+		requests: []string{"jenkins.example.com/whoAmI/api/json"},
+	}, {
+		name:     "anonymous user",
+		data:     data,
+		body:     `{"name":"anonymous","anonymous":true,"authenticated":true}`,
+		requests: []string{"jenkins.example.com/whoAmI/api/json"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "invalid response",
+		data:     data,
+		body:     "<html>",
+		requests: []string{"jenkins.example.com/whoAmI/api/json"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "missing username",
+		data:     map[string][]byte{"baseUrl": data["baseUrl"]},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestBitbucket(t *testing.T) {
+	testVerifier(t, bitbucket, []verifierCase{{
+		name: "valid app password",
+		data: map[string][]byte{
+			"host":        []byte("bitbucket.org"),
+			"username":    []byte("user"),
+			"appPassword": []byte("secret"),
+		},
+		auth:     hasBasic("user", "secret"),
+		requests: []string{"api.bitbucket.org/2.0/user"},
+	}, {
+		name: "rejected app password",
+		data: map[string][]byte{
+			"host":        []byte("bitbucket.org"),
+			"username":    []byte("user"),
+			"appPassword": []byte("wrong"),
+		},
+		auth:     hasBasic("user", "secret"),
+		requests: []string{"api.bitbucket.org/2.0/user"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "missing app password",
+		data:     map[string][]byte{"host": []byte("bitbucket.org")},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestGitLab(t *testing.T) {
+	auth := func(r *http.Request) bool {
+		return r.Header.Get("PRIVATE-TOKEN") == "token"
+	}
+	testVerifier(t, gitLab, []verifierCase{{
+		name: "default port",
+		data: map[string][]byte{
+			"host":  []byte("gitlab.example.com"),
+			"port":  []byte("443"),
+			"token": []byte("token"),
+		},
+		auth:     auth,
+		requests: []string{"gitlab.example.com/api/v4/user"},
+	}, {
+		name: "custom port",
+		data: map[string][]byte{
+			"host":  []byte("gitlab.example.com"),
+			"port":  []byte("8443"),
+			"token": []byte("token"),
+		},
+		auth:     auth,
+		requests: []string{"gitlab.example.com:8443/api/v4/user"},
+	}, {
+		name: "rejected token",
+		data: map[string][]byte{
+			"host":  []byte("gitlab.example.com"),
+			"token": []byte("wrong"),
+		},
+		auth:     auth,
+		requests: []string{"gitlab.example.com/api/v4/user"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "missing token",
+		data:     map[string][]byte{"host": []byte("gitlab.example.com")},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
+
+func TestGitHub(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating the private key: %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	// auth accepts a signed JWT bearer, header, claims and signature.
+	auth := func(r *http.Request) bool {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		return ok && len(strings.Split(token, ".")) == 3
+	}
+
+	testVerifier(t, gitHub, []verifierCase{{
+		name:     "public GitHub",
+		data:     map[string][]byte{"id": []byte("123"), "pem": pemKey},
+		auth:     auth,
+		requests: []string{"api.github.com/app"},
+	}, {
+		name: "GitHub Enterprise",
+		data: map[string][]byte{
+			"id":   []byte("123"),
+			"pem":  pemKey,
+			"host": []byte("ghe.example.com"),
+		},
+		auth:     auth,
+		requests: []string{"ghe.example.com/api/v3/app"},
+	}, {
+		name: "rejected app",
+		data: map[string][]byte{"id": []byte("123"), "pem": pemKey},
+		auth: func(*http.Request) bool {
+			return false
+		},
+		requests: []string{"api.github.com/app"},
+		err:      ErrVerificationFailed,
+	}, {
+		name:     "missing private key",
+		data:     map[string][]byte{"id": []byte("123")},
+		requests: []string{},
+		err:      githubapp.ErrIncompleteSecret,
+	}})
+}
+
+func TestTrustedArtifactSigner(t *testing.T) {
+	testVerifier(t, trustedArtifactSigner, []verifierCase{{
+		name: "reachable services",
+		data: map[string][]byte{
+			"rekor_url": []byte("https://rekor.example.com/"),
+			"tuf_url":   []byte("https://tuf.example.com"),
+		},
+		requests: []string{
+			"rekor.example.com/api/v1/log",
+			"tuf.example.com/root.json",
+		},
+	}, {
+		name:     "missing TUF URL",
+		data:     map[string][]byte{"rekor_url": []byte("https://rekor.example.com")},
+		requests: []string{},
+		err:      ErrMissingData,
+	}})
+}
@@ -0,0 +1,148 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Verifier asserts the integration credentials work, with a cheap authenticated
+// call to the service using the integration secret data.
+type Verifier func(ctx context.Context, c *Client, data map[string][]byte) error
+
+// Client HTTP client used by the verifiers.
+type Client struct {
+	http *http.Client // http client
+}
+
+var (
+	// ErrVerificationFailed the service rejected the integration credentials, or
+	// isn't reachable.
+	ErrVerificationFailed = errors.New("integration verification failed")
+	// ErrMissingData the integration secret lacks the data to verify.
+	ErrMissingData = errors.New("integration secret data missing")
+)
+
+// timeout for each verification request.
+const timeout = 30 * time.Second
+
+// verifiers the integration verifiers, by integration name.
+var verifiers = map[string]Verifier{
+	"acs":         acs,
+	"artifactory": containerRegistry,
+	"bitbucket":   bitbucket,
+	"github":      gitHub,
+	"gitlab":      gitLab,
+	"jenkins":     jenkins,
+	"nexus":       containerRegistry,
+	"quay":        quay,
+	"tas":         trustedArtifactSigner,
+}
+
+// For returns the verifier for the integration, and whether it's supported.
+func For(name string) (Verifier, bool) {
+	v, ok := verifiers[name]
+	return v, ok
+}
+
+// Names returns the integrations supporting verification, sorted.
+func Names() []string {
+	return slices.Sorted(maps.Keys(verifiers))
+}
+
+// required returns the informed keys of the secret data, or an error naming the
+// missing ones.
+func required(data map[string][]byte, keys ...string) ([]string, error) {
+	values := make([]string, 0, len(keys))
+	var missing []string
+	for _, k := range keys {
+		if len(data[k]) == 0 {
+			missing = append(missing, k)
+		}
+		values = append(values, string(data[k]))
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrMissingData, missing)
+	}
+	return values, nil
+}
+
+// do requests the URL, the decorate function sets the credentials. It returns
+// the response, with the body read, whatever the status is.
+func (c *Client) do(
+	ctx context.Context,
+	url string,
+	decorate func(r *http.Request),
+) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if decorate != nil {
+		decorate(req)
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrVerificationFailed, err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, body, nil
+}
+
+// successful returns an error when the response status isn't successful.
+func successful(url string, res *http.Response) error {
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("%w: GET %s: %s",
+			ErrVerificationFailed, url, res.Status)
+	}
+	return nil
+}
+
+// Get requests the URL, the decorate function sets the credentials. It returns
+// the response body when the status is successful.
+func (c *Client) Get(
+	ctx context.Context,
+	url string,
+	decorate func(r *http.Request),
+) ([]byte, error) {
+	res, body, err := c.do(ctx, url, decorate)
+	if err != nil {
+		return nil, err
+	}
+	if err = successful(url, res); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Verify verifies the named integration with the secret data.
+func (c *Client) Verify(
+	ctx context.Context,
+	name string,
+	data map[string][]byte,
+) error {
+	v, ok := For(name)
+	if !ok {
+		return fmt.Errorf("integration %q doesn't support verification", name)
+	}
+	return v(ctx, c, data)
+}
+
+// NewClient instantiates the verification client, the HTTP client is optional.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &Client{http: httpClient}
+}
@@ -0,0 +1,139 @@
+package verify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// verifierCase a verifier test case, the fake service accepts the requests
+// authorized by "auth" and responds with "body".
+type verifierCase struct {
+	name      string                     // test case name
+	data      map[string][]byte          // integration secret data
+	auth      func(r *http.Request) bool // accepted requests, nil accepts all
+	challenge string                     // "WWW-Authenticate" of the rejections
+	body      string                     // response body
+	requests  []string                   // requests expected, "host/path"
+	err       error                      // error expected, if any
+}
+
+// newFakeService starts a TLS server receiving every request, whatever the URL
+// host is, and returns the client dialing it. The requests served are recorded
+// as "host/path".
+func newFakeService(
+	t *testing.T,
+	handler http.HandlerFunc,
+) (*Client, *[]string) {
+	requests := []string{}
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Host+r.URL.Path)
+			handler(w, r)
+		}))
+	t.Cleanup(server.Close)
+
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	// The server certificate doesn't cover the hosts requested.
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	return NewClient(&http.Client{Transport: transport}), &requests
+}
+
+// testVerifier runs the verifier on each case against the fake service.
+func testVerifier(t *testing.T, v Verifier, cases []verifierCase) {
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newFakeService(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.auth != nil && !tt.auth(r) {
+					if tt.challenge != "" {
+						w.Header().Set("WWW-Authenticate", tt.challenge)
+					}
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			err := v(context.TODO(), c, tt.data)
+			switch {
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Errorf("verifier error = %v, want %v", err, tt.err)
+			case tt.err == nil && err != nil:
+				t.Errorf("verifier error = %v, want success", err)
+			}
+			if !slices.Equal(*requests, tt.requests) {
+				t.Errorf("requests:\n got: %v\nwant: %v", *requests, tt.requests)
+			}
+		})
+	}
+}
+
+func TestClient_Get(t *testing.T) {
+	c, requests := newFakeService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	t.Run("authorized", func(t *testing.T) {
+		body, err := c.Get(context.TODO(), "https://service.example.com/api",
+			bearer("token"))
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		if string(body) != "ok" {
+			t.Errorf("Get() body = %q, want %q", body, "ok")
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		_, err := c.Get(context.TODO(), "https://service.example.com/api",
+			bearer("wrong"))
+		if !errors.Is(err, ErrVerificationFailed) ||
+			!strings.Contains(err.Error(), "403 Forbidden") {
+			t.Errorf("Get() = %v, want %v with the status", err,
+				ErrVerificationFailed)
+		}
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		_, err := NewClient(nil).Get(context.TODO(), "https://127.0.0.1:1/", nil)
+		if !errors.Is(err, ErrVerificationFailed) {
+			t.Errorf("Get() = %v, want %v", err, ErrVerificationFailed)
+		}
+	})
+
+	if len(*requests) != 2 {
+		t.Errorf("requests served %v, want 2", *requests)
+	}
+}
+
+func TestClient_Verify(t *testing.T) {
+	want := []string{
+		"acs", "artifactory", "bitbucket", "github", "gitlab", "jenkins",
+		"nexus", "quay", "tas",
+	}
+	if got := Names(); !slices.Equal(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+
+	err := NewClient(nil).Verify(context.TODO(), "azure", nil)
+	if err == nil || !strings.Contains(err.Error(), "doesn't support") {
+		t.Errorf("Verify(azure) = %v, want unsupported", err)
+	}
+	err = NewClient(nil).Verify(context.TODO(), "acs", nil)
+	if !errors.Is(err, ErrMissingData) {
+		t.Errorf("Verify(acs) = %v, want %v", err, ErrMissingData)
+	}
+}
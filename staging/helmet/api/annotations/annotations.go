@@ -0,0 +1,26 @@
+// Package annotations exposes the Helm chart annotations and labels managed by
+// the framework, consumers use RepoURI as prefix for their own.
+package annotations
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/annotations"
+)
+
+// RepoURI is the reverse domain notation URI used as prefix for all
+// annotations and labels managed by the framework.
+const RepoURI = annotations.RepoURI
+
+// Annotation keys for Helm chart metadata, and the installer configuration
+// label.
+const (
+	ProductName          = annotations.ProductName
+	DependsOn            = annotations.DependsOn
+	Weight               = annotations.Weight
+	UseProductNamespace  = annotations.UseProductNamespace
+	IntegrationsProvided = annotations.IntegrationsProvided
+	IntegrationsRequired = annotations.IntegrationsRequired
+	Condition            = annotations.Condition
+	PostDeploy           = annotations.PostDeploy
+	Config               = annotations.Config
+	Instance             = annotations.Instance
+)
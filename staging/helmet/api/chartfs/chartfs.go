@@ -0,0 +1,21 @@
+// Package chartfs exposes the installer filesystem, the Helm charts payload and
+// the installer configuration and values template files.
+package chartfs
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+)
+
+// ChartFS the installer filesystem.
+type ChartFS = chartfs.ChartFS
+
+// OverlayFS looks for files in the embedded filesystem first, then the local.
+type OverlayFS = chartfs.OverlayFS
+
+var (
+	// New instantiates the installer filesystem on any fs.FS, e.g.
+	// os.DirFS("installer").
+	New = chartfs.New
+	// NewOverlayFS combines the embedded and local filesystems.
+	NewOverlayFS = chartfs.NewOverlayFS
+)
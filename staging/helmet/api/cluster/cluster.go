@@ -0,0 +1,46 @@
+// Package cluster exposes the framework's cluster discovery, the Kubernetes
+// distribution and ingress of the target cluster, the ".Cluster" variables on the
+// values template.
+package cluster
+
+import "github.com/redhat-appstudio/helmet/internal/cluster"
+
+// Flavor the Kubernetes distribution of the cluster.
+type Flavor = cluster.Flavor
+
+// IngressKind the API used to expose services outside of the cluster.
+type IngressKind = cluster.IngressKind
+
+// IngressInfo the cluster ingress attributes.
+type IngressInfo = cluster.IngressInfo
+
+// Cluster the target cluster attributes relevant to the installer.
+type Cluster = cluster.Cluster
+
+// Discovery inspects the cluster to determine its flavor and ingress.
+type Discovery = cluster.Discovery
+
+const (
+	// OpenShift cluster, services are exposed with Routes.
+	OpenShift = cluster.OpenShift
+	// Kubernetes vanilla cluster, services are exposed with Ingress or Gateway API.
+	Kubernetes = cluster.Kubernetes
+
+	// Route OpenShift Routes.
+	Route = cluster.Route
+	// Ingress Kubernetes Ingress.
+	Ingress = cluster.Ingress
+	// Gateway Kubernetes Gateway API.
+	Gateway = cluster.Gateway
+
+	// IngressDomainSetting the installer setting overriding the ingress domain.
+	IngressDomainSetting = cluster.IngressDomainSetting
+)
+
+var (
+	// ErrIngressDomainNotFound the ingress domain can't be determined.
+	ErrIngressDomainNotFound = cluster.ErrIngressDomainNotFound
+
+	// NewDiscovery instantiates the cluster discovery.
+	NewDiscovery = cluster.NewDiscovery
+)
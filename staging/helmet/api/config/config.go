@@ -0,0 +1,71 @@
+// Package config exposes the installer configuration, stored on the cluster by
+// the "config" subcommand, and its schema migrations.
+package config
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/constants"
+)
+
+// Filename the installer configuration file, and the ConfigMap key holding it.
+const Filename = constants.ConfigFilename
+
+// Selector label selector for the installer configuration ConfigMap.
+const Selector = config.Selector
+
+// SchemaVersionKey the configuration attribute holding the layout version.
+const SchemaVersionKey = config.SchemaVersionKey
+
+type (
+	// Config the installer configuration.
+	Config = config.Config
+	// Spec the configuration sections.
+	Spec = config.Spec
+	// Settings the installer settings.
+	Settings = config.Settings
+	// Product the configuration of a specific product.
+	Product = config.Product
+	// Products the list of products.
+	Products = config.Products
+	// ConfigMapManager manages the configuration stored in the cluster.
+	ConfigMapManager = config.ConfigMapManager //nolint:revive
+	// Migration a configuration layout transformation.
+	Migration = config.Migration
+	// MigrateFn transforms the configuration application node in place.
+	MigrateFn = config.MigrateFn
+)
+
+var (
+	// ErrInvalidConfig the configuration content is invalid.
+	ErrInvalidConfig = config.ErrInvalidConfig
+	// ErrConfigMapNotFound the configuration ConfigMap is not found.
+	ErrConfigMapNotFound = config.ErrConfigMapNotFound
+	// ErrUnsupportedSchemaVersion the configuration is newer than supported.
+	ErrUnsupportedSchemaVersion = config.ErrUnsupportedSchemaVersion
+)
+
+var (
+	// NewConfigFromBytes instantiates the configuration from the payload, the
+	// root key is the application identifier name.
+	NewConfigFromBytes = config.NewConfigFromBytes
+	// NewConfigMapManager instantiates the cluster configuration manager.
+	NewConfigMapManager = config.NewConfigMapManager
+
+	// RegisterMigration registers a configuration layout migration.
+	RegisterMigration = config.RegisterMigration
+	// CurrentSchemaVersion returns the latest configuration layout version.
+	CurrentSchemaVersion = config.CurrentSchemaVersion
+
+	// MappingValue returns the value node for the key on a mapping node.
+	MappingValue = config.MappingValue
+	// SetMappingValue sets the key on a mapping node, keeping comments.
+	SetMappingValue = config.SetMappingValue
+	// InsertMappingValue inserts the key at the beginning of a mapping node.
+	InsertMappingValue = config.InsertMappingValue
+	// RenameMappingKey renames the key on a mapping node.
+	RenameMappingKey = config.RenameMappingKey
+	// DeleteMappingKey removes the key from a mapping node.
+	DeleteMappingKey = config.DeleteMappingKey
+	// ScalarNode returns a new scalar node for the value and YAML tag.
+	ScalarNode = config.ScalarNode
+)
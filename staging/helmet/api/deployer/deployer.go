@@ -0,0 +1,24 @@
+// Package deployer exposes the framework's Helm deployer, for consumers
+// inspecting the releases the same way they're deployed, e.g. the resources of
+// a deployed release.
+package deployer
+
+import "github.com/redhat-appstudio/helmet/internal/deployer"
+
+// Helm the Helm deployer bound to a single chart, the release is named after
+// the chart, scoped by the installation instance.
+type Helm = deployer.Helm
+
+// Collector collects the release resources.
+type Collector = deployer.Collector
+
+// CollectorFunc adapts the function as a Collector.
+type CollectorFunc = deployer.CollectorFunc
+
+var (
+	// ErrReleaseNotLoaded the release isn't deployed, nor loaded, yet.
+	ErrReleaseNotLoaded = deployer.ErrReleaseNotLoaded
+
+	// NewHelm instantiates the Helm deployer for the chart on the namespace.
+	NewHelm = deployer.NewHelm
+)
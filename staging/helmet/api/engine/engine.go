@@ -0,0 +1,26 @@
+// Package engine exposes the framework's values template engine, rendering the
+// "values.yaml.tpl" with the same functions and variables used to deploy the
+// charts.
+package engine
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/constants"
+	"github.com/redhat-appstudio/helmet/internal/engine"
+)
+
+// ValuesFilename the values template file on the installer filesystem.
+const ValuesFilename = constants.ValuesFilename
+
+// Engine the values template engine.
+type Engine = engine.Engine
+
+// Variables the variables available on the values template.
+type Variables = engine.Variables
+
+var (
+	// NewEngine instantiates the template engine, the "lookup" function reads
+	// the informed Kubernetes client.
+	NewEngine = engine.NewEngine
+	// NewVariables instantiates empty template variables.
+	NewVariables = engine.NewVariables
+)
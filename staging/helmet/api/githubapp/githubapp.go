@@ -0,0 +1,43 @@
+// Package githubapp exposes the framework's GitHub App manifest, the permissions,
+// events and visibility "integration github" creates the application with, so
+// consumers import existing applications against the same requirements.
+package githubapp
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/githubapp"
+)
+
+// Requirements the permissions and webhook events the GitHub App must have.
+type Requirements = githubapp.Requirements
+
+// Spec the GitHub App specification, the "githubApp" installer setting.
+type Spec = githubapp.Spec
+
+// Manifest the resolved GitHub App permissions, events and visibility.
+type Manifest = githubapp.Manifest
+
+const (
+	// SettingName installer setting with the GitHub App specification.
+	SettingName = githubapp.SettingName
+	// PresetFull the permissions for Pipelines-as-Code and Developer Hub.
+	PresetFull = githubapp.PresetFull
+	// PresetPACOnly the permissions for Pipelines-as-Code.
+	PresetPACOnly = githubapp.PresetPACOnly
+	// PresetRHDHOnly the permissions for Developer Hub.
+	PresetRHDHOnly = githubapp.PresetRHDHOnly
+)
+
+// ErrInvalidSpec the GitHub App specification is invalid.
+var ErrInvalidSpec = githubapp.ErrInvalidSpec
+
+var (
+	// Presets returns the preset names, sorted.
+	Presets = githubapp.Presets
+	// Preset returns the named preset requirements, and whether it exists.
+	Preset = githubapp.Preset
+	// SpecFromSettings decodes the specification from the setting value.
+	SpecFromSettings = githubapp.SpecFromSettings
+	// ResolveManifest resolves the manifest from the installer setting, and the
+	// "integration github" flags informed.
+	ResolveManifest = githubapp.ResolveManifest
+)
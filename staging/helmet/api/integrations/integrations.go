@@ -0,0 +1,43 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redhat-appstudio/helmet/api/cluster"
+	"github.com/redhat-appstudio/helmet/api/config"
+)
+
+// ErrHostNotFound the Route, Ingress or HTTPRoute exposing the service is not
+// found on the cluster.
+var ErrHostNotFound = errors.New("host not found")
+
+// IntegrationContext provides cluster and installer configuration
+// to a URLProvider without exposing internal types. Implementations
+// are supplied by the framework when calling the provider.
+type IntegrationContext interface {
+	// GetOpenShiftIngressDomain returns the OpenShift ingress domain for the cluster.
+	// Returns an error if the cluster is not OpenShift or the domain cannot be determined.
+	GetOpenShiftIngressDomain(ctx context.Context) (string, error)
+	// GetProductNamespace returns the namespace for the named product from installer config.
+	// Returns an error if the product is not found.
+	GetProductNamespace(productName string) (string, error)
+	// GetConfig returns the installer configuration, loaded once by the framework.
+	GetConfig() *config.Config
+	// GetCluster returns the discovered cluster attributes, such as the ingress
+	// domain, regardless of the Kubernetes distribution.
+	GetCluster(ctx context.Context) (*cluster.Cluster, error)
+	// GetHost returns the host exposing the named service, inspecting the
+	// OpenShift Route, the Ingress and the Gateway API HTTPRoute on the
+	// namespace, in this order. Returns ErrHostNotFound when none is found.
+	GetHost(ctx context.Context, namespace, name string) (string, error)
+}
+
+// URLProvider supplies URLs (callback for authentication, homepage, webhook).
+// Used with framework.WithURLProvider. Implementations receive an
+// IntegrationContext to derive URLs from cluster/config without importing internal.
+type URLProvider interface {
+	GetCallbackURL(ctx context.Context, ic IntegrationContext) (string, error)
+	GetHomepageURL(ctx context.Context, ic IntegrationContext) (string, error)
+	GetWebhookURL(ctx context.Context, ic IntegrationContext) (string, error)
+}
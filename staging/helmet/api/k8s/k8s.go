@@ -0,0 +1,33 @@
+// Package k8s exposes the framework's Kubernetes client to consumers, the same
+// client used by the standard subcommands, and the fake client for tests and
+// offline usage.
+package k8s
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+)
+
+// Interface abstracts Kubernetes client operations for both real and fake
+// clusters.
+type Interface = k8s.Interface
+
+// Kube the Kubernetes client helper, using the "kubeconfig" informed on the
+// global flags.
+type Kube = k8s.Kube
+
+// FakeKube the Kubernetes client backed by in-memory objects.
+type FakeKube = k8s.FakeKube
+
+// ErrClientNotConnected kubernetes clients is not able to access the API.
+var ErrClientNotConnected = k8s.ErrClientNotConnected
+
+var (
+	// NewKube instantiates the Kubernetes client helper for the global flags.
+	NewKube = k8s.NewKube
+	// NewFakeKube instantiates the fake Kubernetes client with the informed
+	// objects.
+	NewFakeKube = k8s.NewFakeKube
+	// NewHelmActionConfig returns the Helm action configuration for the
+	// namespace, the same configuration used to deploy the charts.
+	NewHelmActionConfig = k8s.NewHelmActionConfig
+)
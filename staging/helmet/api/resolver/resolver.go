@@ -0,0 +1,73 @@
+// Package resolver exposes the framework's dependency resolver, the topology of
+// Helm charts "deploy" installs, in the same order.
+package resolver
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/resolver"
+)
+
+// Collection the installer Helm charts, indexed by name.
+type Collection = resolver.Collection
+
+// Dependency a Helm chart and the namespace it's deployed on.
+type Dependency = resolver.Dependency
+
+// Dependencies a slice of Dependency.
+type Dependencies = resolver.Dependencies
+
+// Topology the dependencies in the order they are deployed.
+type Topology = resolver.Topology
+
+// CEL evaluates the "integrations-required" and "condition" expressions.
+type CEL = resolver.CEL
+
+// Reason the provenance of a chart on the topology.
+type Reason = resolver.Reason
+
+// ReasonKind why a chart is part of the topology, or why it was left out.
+type ReasonKind = resolver.ReasonKind
+
+// Provenance reason kinds.
+const (
+	ReasonProduct   = resolver.ReasonProduct
+	ReasonDependsOn = resolver.ReasonDependsOn
+	ReasonAttached  = resolver.ReasonAttached
+	ReasonSkipped   = resolver.ReasonSkipped
+	ReasonCondition = resolver.ReasonCondition
+)
+
+// Requirements the integrations to configure before deploying the topology.
+type Requirements = resolver.Requirements
+
+// Resolver resolves the topology from the collection and the configuration.
+type Resolver = resolver.Resolver
+
+var (
+	// ErrCircularDependency reports a circular dependency.
+	ErrCircularDependency = resolver.ErrCircularDependency
+	// ErrMissingDependency reports an unmet dependency.
+	ErrMissingDependency = resolver.ErrMissingDependency
+	// ErrDependencyNotFound the chart isn't in the collection.
+	ErrDependencyNotFound = resolver.ErrDependencyNotFound
+	// ErrInvalidExpression reports an invalid CEL expression.
+	ErrInvalidExpression = resolver.ErrInvalidExpression
+	// ErrMissingIntegrations reports required integrations not configured.
+	ErrMissingIntegrations = resolver.ErrMissingIntegrations
+	// ErrUnsatisfiable no set of integrations satisfies the topology.
+	ErrUnsatisfiable = resolver.ErrUnsatisfiable
+)
+
+var (
+	// NewCollection indexes the informed charts.
+	NewCollection = resolver.NewCollection
+	// NewTopology instantiates an empty topology.
+	NewTopology = resolver.NewTopology
+	// NewResolver instantiates the resolver, Resolve populates the topology.
+	NewResolver = resolver.NewResolver
+	// NewDependency instantiates a dependency for the informed chart.
+	NewDependency = resolver.NewDependency
+	// NewCEL instantiates the CEL environment for the integration names.
+	NewCEL = resolver.NewCEL
+	// NewRequirements computes the integrations the topology requires.
+	NewRequirements = resolver.NewRequirements
+)
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+)
+
+// RunContext carries the runtime dependencies shared by the framework
+// subcommands: Kubernetes client, installer filesystem and logger. Consumers
+// obtain the application's instance with framework.App.RunContext, so their own
+// subcommands share the same dependencies.
+//
+// This is a re-export of the framework's run context.
+type RunContext = runcontext.RunContext
+
+// Flags represents the global flags registered on the root command, such as
+// "--dry-run", "--debug" and "--kube-config". Values are populated once the
+// command-line is parsed.
+//
+// This is a re-export of the framework's global flags.
+type Flags = flags.Flags
+
+// KubeConfigFlag is the global flag name for the kubeconfig file path.
+const KubeConfigFlag = flags.KubeConfigFlag
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/integration"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+)
+
+// SecretWriter writes the integration secrets it's enabled for, for instance as
+// GitOps manifests, instead of storing them in the cluster.
+type SecretWriter = integration.SecretWriter
+
+// Integration manages the integration secret, created from the integration
+// module data, or from the payload informed to Store. The secret writer is
+// honored on every write.
+//
+// This is a re-export of the framework's integration.
+type Integration = integration.Integration
+
+var (
+	// ErrSecretAlreadyExists the integration secret exists, and "--force" isn't
+	// informed.
+	ErrSecretAlreadyExists = integration.ErrSecretAlreadyExists
+	// ErrSecretNotFound the integration secret isn't in the cluster.
+	ErrSecretNotFound = integration.ErrSecretNotFound
+)
+
+// IsPublicIntegrationKey tells whether the integration secret data key holds a
+// non-sensitive value, such as URLs and hostnames, safe to show.
+var IsPublicIntegrationKey = integration.IsPublicKey
+
+// Integrations the integrations registered on the application, by name, see
+// framework.App.Integrations.
+type Integrations interface {
+	// IntegrationNames returns the registered integration names, sorted.
+	IntegrationNames() []string
+
+	// Lookup returns the named integration, and whether it's registered.
+	Lookup(name string) (*Integration, bool)
+
+	// ConfiguredIntegrations returns the integration names with a secret in the
+	// cluster.
+	ConfiguredIntegrations(context.Context, *config.Config) ([]string, error)
+}
+
+// IntegrationModule defines the contract for a pluggable integration.
+// It encapsulates both the integration business logic (integration.Interface) and
+// the CLI representation (SubCommand).
+type IntegrationModule struct {
+	// Name is the unique name of the integration (e.g., "github", "acs").
+	Name string
+
+	// Init creates the integration business logic instance.
+	Init func(*slog.Logger, k8s.Interface) integration.Interface
+
+	// Command creates the CLI subcommand for this integration.
+	// It receives the application context, run context, and initialized integration wrapper.
+	Command func(
+		*AppContext,
+		*runcontext.RunContext,
+		*integration.Integration,
+	) SubCommand
+
+	// Endpoints supplies the URLs the integration is configured with on the
+	// external service, e.g. the webhook and authentication callback. Optional,
+	// when set the URLs are shown once the integration is stored.
+	Endpoints URLProvider
+}
@@ -0,0 +1,216 @@
+package framework
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/mcptools"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+	"github.com/redhat-appstudio/helmet/internal/subcmd"
+
+	"github.com/spf13/cobra"
+)
+
+// App represents the installer application runtime.
+// It holds runtime dependencies and coordinates the execution of commands.
+// Application metadata (name, version, etc.) is stored in AppCtx.
+type App struct {
+	AppCtx  *api.AppContext  // application metadata (single source of truth)
+	ChartFS *chartfs.ChartFS // installer filesystem
+
+	integrations       []api.IntegrationModule // supported integrations
+	integrationManager *integrations.Manager   // integrations manager
+	secretWriter       api.SecretWriter        // integration secrets writer
+	out                io.Writer               // logger and printers output
+	rootCmd            *cobra.Command          // root cobra instance
+	flags              *flags.Flags            // global flags
+	kube               *k8s.Kube               // kubernetes client
+	runCtx             *runcontext.RunContext  // shared run context
+
+	mcpToolsBuilder  mcptools.MCPToolsBuilder // tools builder
+	mcpImage         string                   // installer image
+	installerTarball []byte                   // embedded installer tarball
+}
+
+// Command exposes the Cobra command.
+func (a *App) Command() *cobra.Command {
+	return a.rootCmd
+}
+
+// RunContext exposes the run context shared by the standard subcommands, the
+// consumer's own subcommands use it to share the Kubernetes client, installer
+// filesystem and logger.
+func (a *App) RunContext() *api.RunContext {
+	return a.runCtx
+}
+
+// Integrations exposes the integrations registered on the application, the
+// consumer's own subcommands manage the integration secrets with them.
+func (a *App) Integrations() api.Integrations {
+	return a.integrationManager
+}
+
+// Flags exposes the global flags, populated once the command-line is parsed.
+func (a *App) Flags() *api.Flags {
+	return a.flags
+}
+
+// Run is a shortcut Cobra's Execute method.
+func (a *App) Run() error {
+	return a.rootCmd.Execute()
+}
+
+// setupRootCmd instantiates the Cobra Root command with subcommand, description,
+// Kubernetes API client instance and more.
+func (a *App) setupRootCmd() error {
+	short := a.AppCtx.Short
+	if short == "" {
+		short = a.AppCtx.Name + " installer"
+	}
+
+	a.rootCmd = &cobra.Command{
+		Use:          a.AppCtx.Name,
+		Short:        short,
+		Long:         a.AppCtx.Long,
+		SilenceUsage: true,
+	}
+
+	// Add persistent flags.
+	a.flags.PersistentFlags(a.rootCmd.PersistentFlags())
+
+	// Handle version flag and help.
+	a.rootCmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		if a.flags.Version {
+			a.flags.ShowVersion(
+				a.AppCtx.Name, a.AppCtx.Version, a.AppCtx.CommitID)
+			return nil
+		}
+		return cmd.Help()
+	}
+
+	logger := a.flags.GetLogger(a.out)
+	runCtx := runcontext.NewRunContext(a.kube, a.ChartFS, a.flags, logger, a.out)
+	a.runCtx = runCtx
+
+	// Loading informed integrations into the manager.
+	a.integrationManager = integrations.NewManager()
+	if err := a.integrationManager.LoadModules(
+		a.AppCtx.Name, runCtx, a.integrations,
+	); err != nil {
+		return fmt.Errorf("failed to load modules: %w", err)
+	}
+	if a.secretWriter != nil {
+		a.integrationManager.SetSecretWriter(a.secretWriter)
+	}
+
+	// Register standard subcommands.
+	a.rootCmd.AddCommand(subcmd.NewIntegration(
+		a.AppCtx, runCtx, a.integrationManager,
+	))
+
+	// Use default builder if none provided.
+	mcpBuilder := a.mcpToolsBuilder
+	if mcpBuilder == nil {
+		mcpBuilder = subcmd.StandardMCPToolsBuilder()
+	}
+
+	// Validate MCP image is configured.
+	if a.mcpImage == "" {
+		return fmt.Errorf(
+			"MCP server image not configured: use WithMCPImage() option")
+	}
+
+	// Other subcommands via api.Runner.
+	subs := []api.SubCommand{
+		subcmd.NewConfig(a.AppCtx, runCtx, a.flags, a.integrationManager),
+		subcmd.NewDeploy(a.AppCtx, runCtx, a.flags, a.integrationManager, a.installerTarball),
+		subcmd.NewInstaller(a.AppCtx, runCtx, a.flags, a.installerTarball),
+		subcmd.NewMCPServer(a.AppCtx, runCtx, a.flags, a.integrationManager, mcpBuilder, a.mcpImage),
+		subcmd.NewTemplate(a.AppCtx, runCtx, a.flags, a.integrationManager, a.installerTarball),
+		subcmd.NewTopology(a.AppCtx, runCtx, a.integrationManager),
+	}
+	for _, sub := range subs {
+		a.rootCmd.AddCommand(api.NewRunner(sub).Cmd())
+	}
+	return nil
+}
+
+// NewApp creates a new installer application runtime.
+// It automatically sets up the Cobra Root Command and standard subcommands.
+//
+// The appCtx parameter provides application metadata (name, version, etc.).
+// The cfs parameter provides access to the installer filesystem (charts, config).
+// Additional runtime options can be passed via functional options.
+func NewApp(
+	appCtx *api.AppContext,
+	cfs *chartfs.ChartFS,
+	opts ...Option,
+) (*App, error) {
+	app := &App{
+		AppCtx:  appCtx,
+		ChartFS: cfs,
+		flags:   flags.NewFlags(),
+		out:     os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(app)
+	}
+
+	// Initialize Kube client with flags
+	app.kube = k8s.NewKube(app.flags)
+
+	if err := app.setupRootCmd(); err != nil {
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// NewAppFromTarball creates a new installer application from an embedded tarball.
+// This is a convenience constructor that handles the internal filesystem setup,
+// making it easier for external consumers to create an App instance.
+//
+// Parameters:
+//   - appCtx: Application metadata (name, version, etc.)
+//   - tarball: Embedded installer tarball bytes
+//   - cwd: Current working directory for local filesystem overlay
+//   - opts: Additional runtime options (integrations, MCP image, etc.)
+//
+// The function creates an overlay filesystem combining the embedded tarball
+// contents with the local filesystem at cwd, then initializes the App.
+func NewAppFromTarball(
+	appCtx *api.AppContext,
+	tarball []byte,
+	cwd string,
+	opts ...Option,
+) (*App, error) {
+	// Create tarfs from embedded tarball
+	tfs, err := NewTarFS(tarball)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create overlay filesystem with embedded tarball and local filesystem
+	ofs := chartfs.NewOverlayFS(tfs, os.DirFS(cwd))
+	cfs := chartfs.New(ofs)
+
+	// Append WithInstallerTarball to opts
+	opts = append(opts, WithInstallerTarball(tarball))
+
+	// Create and return the App using the existing constructor
+	return NewApp(appCtx, cfs, opts...)
+}
+
+// StandardIntegrations returns the list of standard integration modules.
+// This exposes the standard integrations (GitHub, GitLab, Quay, etc.)
+// through the public API for use with WithIntegrations option.
+func StandardIntegrations() []api.IntegrationModule {
+	return subcmd.StandardModules()
+}
@@ -0,0 +1,91 @@
+package framework
+
+import (
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/api/config"
+	"github.com/redhat-appstudio/helmet/internal/integration"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+	"github.com/redhat-appstudio/helmet/internal/subcmd"
+)
+
+// NewIntegrations registers the integration modules for the application name,
+// without the application runtime, e.g. to inspect the integrations configured
+// on a fake cluster. The run context informs the Kubernetes client and logger.
+func NewIntegrations(
+	appName string,
+	runCtx *api.RunContext,
+	modules ...api.IntegrationModule,
+) (api.Integrations, error) {
+	manager := integrations.NewManager()
+	if err := manager.LoadModules(appName, runCtx, modules); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
+
+// GitHubModuleWithURLProvider returns a GitHub integration module that uses the
+// given URLProvider for webhook, homepage, and optional callback URLs when not
+// set by flags. Use this in custom installers (e.g. helmet-ex) to supply URLs
+// from config or environment without importing internal.
+func GitHubModuleWithURLProvider(provider api.URLProvider) api.IntegrationModule {
+	return api.IntegrationModule{
+		Name: string(integrations.GitHub),
+		Init: func(l *slog.Logger, _ k8s.Interface) integration.Interface {
+			gh := integration.NewGitHub(l)
+			gh.SetURLProvider(provider)
+			return gh
+		},
+		Command: func(appCtx *api.AppContext, runCtx *runcontext.RunContext, i *integration.Integration) api.SubCommand {
+			return subcmd.NewIntegrationGitHub(appCtx, runCtx, i)
+		},
+	}
+}
+
+// WithURLProvider returns a copy of modules with the GitHub integration
+// replaced by one that uses the given URLProvider. Use after StandardIntegrations()
+// to customize GitHub App URLs (e.g. from env or config) without changing other
+// integrations.
+func WithURLProvider(modules []api.IntegrationModule, provider api.URLProvider) []api.IntegrationModule {
+	out := make([]api.IntegrationModule, 0, len(modules))
+	for _, m := range modules {
+		if m.Name == string(integrations.GitHub) {
+			out = append(out, GitHubModuleWithURLProvider(provider))
+		} else {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// WithEndpoints returns a copy of modules with the named integration declaring
+// the endpoints supplied by the given URLProvider. The integration subcommand
+// shows them once the integration is stored, for the user to configure on the
+// external service (e.g. GitLab or Bitbucket webhooks).
+func WithEndpoints(
+	modules []api.IntegrationModule,
+	name string,
+	provider api.URLProvider,
+) []api.IntegrationModule {
+	out := make([]api.IntegrationModule, 0, len(modules))
+	for _, m := range modules {
+		if m.Name == name {
+			m.Endpoints = provider
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// NewIntegrationContext returns the IntegrationContext the framework calls the
+// URL providers with, for consumers resolving the same endpoints outside of the
+// integration modules, e.g. in their own subcommands.
+func NewIntegrationContext(
+	runCtx *api.RunContext,
+	cfg *config.Config,
+) api.IntegrationContext {
+	return integration.NewIntegrationContext(runCtx, cfg)
+}
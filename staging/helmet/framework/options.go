@@ -0,0 +1,57 @@
+package framework
+
+import (
+	"io"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/mcptools"
+)
+
+// Option represents a functional option for the App runtime.
+// These options configure runtime dependencies and behavior.
+// For application metadata (name, version, etc.), use ContextOption with NewAppContext.
+type Option func(*App)
+
+// WithIntegrations sets the supported integrations for the application.
+func WithIntegrations(modules ...api.IntegrationModule) Option {
+	return func(a *App) {
+		a.integrations = append(a.integrations, modules...)
+	}
+}
+
+// WithOutput sets the output for the logger, the printers and the dry-run output,
+// the standard output by default.
+func WithOutput(w io.Writer) Option {
+	return func(a *App) {
+		a.out = w
+	}
+}
+
+// WithSecretWriter sets the writer for the integration secrets, the integration
+// subcommands write the secrets it's enabled for instead of storing them.
+func WithSecretWriter(w api.SecretWriter) Option {
+	return func(a *App) {
+		a.secretWriter = w
+	}
+}
+
+// WithMCPImage sets the container image for the MCP server.
+func WithMCPImage(image string) Option {
+	return func(a *App) {
+		a.mcpImage = image
+	}
+}
+
+// WithMCPToolsBuilder sets the MCP tools builder for the application.
+func WithMCPToolsBuilder(builder mcptools.MCPToolsBuilder) Option {
+	return func(a *App) {
+		a.mcpToolsBuilder = builder
+	}
+}
+
+// WithInstallerTarball sets the embedded installer tarball for the application.
+func WithInstallerTarball(tarball []byte) Option {
+	return func(a *App) {
+		a.installerTarball = tarball
+	}
+}
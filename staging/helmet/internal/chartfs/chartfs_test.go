@@ -0,0 +1,47 @@
+package chartfs
+
+import (
+	"os"
+	"testing"
+
+	o "github.com/onsi/gomega"
+)
+
+func TestNewChartFS(t *testing.T) {
+	g := o.NewWithT(t)
+
+	c := New(os.DirFS("../../test"))
+	g.Expect(c).ToNot(o.BeNil())
+
+	t.Run("ReadFile", func(t *testing.T) {
+		valuesTmplBytes, err := c.ReadFile("values.yaml.tpl")
+		g.Expect(err).To(o.Succeed())
+		g.Expect(valuesTmplBytes).ToNot(o.BeEmpty())
+	})
+
+	t.Run("GetChartForDep", func(t *testing.T) {
+		chart, err := c.GetChartFiles("charts/helmet-product-a")
+		g.Expect(err).To(o.Succeed())
+		g.Expect(chart).ToNot(o.BeNil())
+		g.Expect(chart.Name()).To(o.Equal("helmet-product-a"))
+		g.Expect(chart.Templates).ToNot(o.BeEmpty())
+
+		// Asserting the chart templates are present, it should contain at least a
+		// few files, plus the presence of the "NOTES.txt" common file.
+		names := make([]string, 0, len(chart.Templates))
+		for _, tmpl := range chart.Templates {
+			names = append(names, tmpl.Name)
+		}
+		g.Expect(len(names)).To(o.BeNumerically("==", 3))
+		g.Expect(names).To(o.ContainElement("templates/NOTES.txt"))
+		g.Expect(names).To(o.ContainElement("templates/_copy-scripts.tpl"))
+		g.Expect(names).To(o.ContainElement("templates/hooks/deploy-order.yaml"))
+	})
+
+	t.Run("GetAllCharts", func(t *testing.T) {
+		charts, err := c.GetAllCharts()
+		g.Expect(err).To(o.Succeed())
+		g.Expect(charts).ToNot(o.BeNil())
+		g.Expect(len(charts)).To(o.BeNumerically(">", 1))
+	})
+}
@@ -0,0 +1,294 @@
+// Package cluster discovers the target cluster attributes relevant to the
+// installer: the Kubernetes distribution and how services are exposed.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Flavor the Kubernetes distribution of the cluster.
+type Flavor string
+
+// IngressKind the API used to expose services outside of the cluster.
+type IngressKind string
+
+const (
+	// OpenShift cluster, services are exposed with Routes.
+	OpenShift Flavor = "openshift"
+	// Kubernetes vanilla cluster, services are exposed with Ingress or Gateway API.
+	Kubernetes Flavor = "kubernetes"
+
+	// Route OpenShift Routes, "route.openshift.io".
+	Route IngressKind = "Route"
+	// Ingress Kubernetes Ingress, "networking.k8s.io".
+	Ingress IngressKind = "Ingress"
+	// Gateway Kubernetes Gateway API, "gateway.networking.k8s.io".
+	Gateway IngressKind = "Gateway"
+)
+
+// IngressDomainSetting the installer setting overriding the ingress domain, for
+// instance "settings.ingressDomain: apps.example.com".
+const IngressDomainSetting = "ingressDomain"
+
+// IngressInfo the cluster ingress attributes.
+type IngressInfo struct {
+	Kind   IngressKind // API used to expose services
+	Class  string      // ingress class or gateway name, if any
+	Domain string      // wildcard domain, services use "<name>.<domain>"
+}
+
+// Cluster represents the target cluster attributes relevant to the installer.
+type Cluster struct {
+	Flavor  Flavor      // kubernetes distribution
+	Ingress IngressInfo // ingress attributes
+}
+
+// Discovery inspects the cluster to determine its flavor and ingress.
+type Discovery struct {
+	logger *slog.Logger  // application logger
+	kube   k8s.Interface // kubernetes client
+}
+
+// ErrIngressDomainNotFound the ingress domain can't be determined.
+var ErrIngressDomainNotFound = errors.New("ingress domain not found")
+
+var (
+	// ingressControllerGVR OpenShift ingress operator controllers.
+	ingressControllerGVR = schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "ingresscontrollers",
+	}
+	// gatewayGVR Gateway API gateways.
+	gatewayGVR = schema.GroupVersionResource{
+		Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways",
+	}
+)
+
+// defaultIngressClassAnnotation marks the default IngressClass.
+const defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+
+// ingressControllerNames well-known ingress controllers, by the
+// "app.kubernetes.io/name" label of their LoadBalancer Service.
+var ingressControllerNames = []string{
+	"ingress-nginx", "traefik", "contour", "envoy", "haproxy-ingress",
+}
+
+// IsOpenShift asserts whether the cluster is OpenShift.
+func (c *Cluster) IsOpenShift() bool {
+	return c.Flavor == OpenShift
+}
+
+// IngressDomain returns the ingress domain, an error when it's not discovered nor
+// informed.
+func (c *Cluster) IngressDomain() (string, error) {
+	if c.Ingress.Domain == "" {
+		return "", fmt.Errorf(
+			"%w: %s cluster, set %q on the installer settings",
+			ErrIngressDomainNotFound, c.Flavor, IngressDomainSetting)
+	}
+	return c.Ingress.Domain, nil
+}
+
+// Values returns the cluster attributes as template variables, ".Cluster" on the
+// values template.
+func (c *Cluster) Values() chartutil.Values {
+	return chartutil.Values{
+		"Flavor":      string(c.Flavor),
+		"IsOpenShift": c.IsOpenShift(),
+		"Ingress": chartutil.Values{
+			"Kind":   string(c.Ingress.Kind),
+			"Class":  c.Ingress.Class,
+			"Domain": c.Ingress.Domain,
+		},
+	}
+}
+
+// hasAPIGroup asserts whether the API group is served by the cluster.
+func (d *Discovery) hasAPIGroup(name string) (bool, error) {
+	cs, err := d.kube.ClientSet("default")
+	if err != nil {
+		return false, err
+	}
+	groups, err := cs.Discovery().ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(groups.Groups, func(g metav1.APIGroup) bool {
+		return g.Name == name
+	}), nil
+}
+
+// openShiftDomain reads the domain of the default OpenShift ingress controller,
+// empty when absent, as on vanilla Kubernetes.
+func (d *Discovery) openShiftDomain(ctx context.Context) string {
+	client, err := d.kube.DynamicClient("openshift-ingress-operator")
+	if err != nil {
+		d.logger.Debug("OpenShift ingress unavailable", "error", err)
+		return ""
+	}
+	ic, err := client.Resource(ingressControllerGVR).
+		Namespace("openshift-ingress-operator").
+		Get(ctx, "default", metav1.GetOptions{})
+	if err != nil {
+		d.logger.Debug("OpenShift ingress unavailable", "error", err)
+		return ""
+	}
+	domain, _, _ := unstructured.NestedString(ic.Object, "status", "domain")
+	return domain
+}
+
+// gatewayDomain inspects the Gateway API gateways, the domain comes from a
+// wildcard listener hostname, or the gateway address. Returns the domain and the
+// gateway name, empty when no gateway informs a domain.
+func (d *Discovery) gatewayDomain(ctx context.Context) (string, string) {
+	client, err := d.kube.DynamicClient("default")
+	if err != nil {
+		d.logger.Debug("Gateway API unavailable", "error", err)
+		return "", ""
+	}
+	gateways, err := client.Resource(gatewayGVR).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.logger.Debug("Gateway API unavailable", "error", err)
+		return "", ""
+	}
+	for _, gw := range gateways.Items {
+		listeners, _, _ := unstructured.NestedSlice(gw.Object, "spec", "listeners")
+		for _, l := range listeners {
+			listener, _ := l.(map[string]any)
+			hostname, _ := listener["hostname"].(string)
+			if domain, found := strings.CutPrefix(hostname, "*."); found {
+				return domain, gw.GetName()
+			}
+		}
+		addresses, _, _ := unstructured.NestedSlice(gw.Object, "status", "addresses")
+		for _, a := range addresses {
+			address, _ := a.(map[string]any)
+			if value, _ := address["value"].(string); value != "" {
+				return addressDomain(value), gw.GetName()
+			}
+		}
+	}
+	return "", ""
+}
+
+// addressDomain returns the domain for the load balancer address, IP addresses
+// use the "nip.io" wildcard DNS service.
+func addressDomain(address string) string {
+	if net.ParseIP(address) != nil {
+		return fmt.Sprintf("%s.nip.io", address)
+	}
+	return address
+}
+
+// ingressDomain inspects the default IngressClass, and the LoadBalancer Service
+// of well-known ingress controllers for the domain. Returns the domain and the
+// ingress class, empty when not found.
+func (d *Discovery) ingressDomain(ctx context.Context) (string, string) {
+	cs, err := d.kube.ClientSet("default")
+	if err != nil {
+		d.logger.Debug("Ingress unavailable", "error", err)
+		return "", ""
+	}
+	class := ""
+	classes, err := cs.NetworkingV1().IngressClasses().
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.logger.Debug("Ingress classes unavailable", "error", err)
+	} else {
+		for _, ic := range classes.Items {
+			if ic.GetAnnotations()[defaultIngressClassAnnotation] == "true" {
+				class = ic.GetName()
+				break
+			}
+		}
+	}
+
+	services, err := cs.CoreV1().Services("").List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app.kubernetes.io/name in (%s)",
+			strings.Join(ingressControllerNames, ",")),
+	})
+	if err != nil {
+		d.logger.Debug("Ingress controllers unavailable", "error", err)
+		return "", class
+	}
+	for _, svc := range services.Items {
+		if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			if lb.Hostname != "" {
+				return lb.Hostname, class
+			}
+			if lb.IP != "" {
+				return addressDomain(lb.IP), class
+			}
+		}
+	}
+	return "", class
+}
+
+// Discover inspects the cluster flavor and ingress. The ingress domain is taken
+// from the "ingressDomain" installer setting, when informed, otherwise it's
+// discovered from the cluster ingress controller, left empty when not found.
+// A Gateway without a domain falls back to Ingress.
+func (d *Discovery) Discover(ctx context.Context, cfg *config.Config) (*Cluster, error) {
+	c := &Cluster{Flavor: Kubernetes, Ingress: IngressInfo{Kind: Ingress}}
+	if cfg != nil {
+		if domain, ok := cfg.Installer.Settings[IngressDomainSetting].(string); ok {
+			c.Ingress.Domain = domain
+		}
+	}
+
+	hasRoutes, err := d.hasAPIGroup("route.openshift.io")
+	if err != nil {
+		return nil, err
+	}
+	// The OpenShift ingress controller asserts OpenShift as well, the API
+	// discovery may not be complete, as offline.
+	openShiftDomain := d.openShiftDomain(ctx)
+	switch {
+	case hasRoutes || openShiftDomain != "":
+		c.Flavor, c.Ingress.Kind = OpenShift, Route
+		if c.Ingress.Domain == "" {
+			c.Ingress.Domain = openShiftDomain
+		}
+	default:
+		if domain, name := d.gatewayDomain(ctx); domain != "" {
+			c.Ingress.Kind, c.Ingress.Class = Gateway, name
+			if c.Ingress.Domain == "" {
+				c.Ingress.Domain = domain
+			}
+			break
+		}
+		domain, class := d.ingressDomain(ctx)
+		c.Ingress.Class = class
+		if c.Ingress.Domain == "" {
+			c.Ingress.Domain = domain
+		}
+	}
+
+	d.logger.Debug("Cluster discovered", "flavor", c.Flavor,
+		"ingress-kind", c.Ingress.Kind, "ingress-class", c.Ingress.Class,
+		"ingress-domain", c.Ingress.Domain)
+	return c, nil
+}
+
+// NewDiscovery instantiates the cluster discovery.
+func NewDiscovery(logger *slog.Logger, kube k8s.Interface) *Discovery {
+	return &Discovery{logger: logger, kube: kube}
+}
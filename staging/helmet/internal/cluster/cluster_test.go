@@ -0,0 +1,115 @@
+package cluster
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+
+	o "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// newGateway returns a gateway with the informed listener hostname.
+func newGateway(name, hostname string) *unstructured.Unstructured {
+	listener := map[string]any{"name": "http", "port": int64(80)}
+	if hostname != "" {
+		listener["hostname"] = hostname
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "Gateway",
+		"metadata":   map[string]any{"name": name, "namespace": "default"},
+		"spec":       map[string]any{"listeners": []any{listener}},
+	}}
+}
+
+// ingressService the LoadBalancer Service of a well-known ingress controller.
+var ingressService = &corev1.Service{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "ingress-nginx-controller",
+		Namespace: "ingress-nginx",
+		Labels:    map[string]string{"app.kubernetes.io/name": "ingress-nginx"},
+	},
+	Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+		Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+	}},
+}
+
+func TestDiscovery_Discover(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name    string           // test case name
+		objects []runtime.Object // cluster objects
+		setting string           // "ingressDomain" setting
+		want    Cluster          // discovered cluster
+	}{{
+		name: "OpenShift",
+		objects: []runtime.Object{&unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "operator.openshift.io/v1",
+			"kind":       "IngressController",
+			"metadata": map[string]any{
+				"name":      "default",
+				"namespace": "openshift-ingress-operator",
+			},
+			"status": map[string]any{"domain": "apps.openshift.example.com"},
+		}}},
+		want: Cluster{Flavor: OpenShift, Ingress: IngressInfo{
+			Kind: Route, Domain: "apps.openshift.example.com",
+		}},
+	}, {
+		name: "Gateway",
+		objects: []runtime.Object{
+			ingressService, newGateway("public", "*.apps.example.com"),
+		},
+		want: Cluster{Flavor: Kubernetes, Ingress: IngressInfo{
+			Kind: Gateway, Class: "public", Domain: "apps.example.com",
+		}},
+	}, {
+		name:    "Gateway without a domain",
+		objects: []runtime.Object{ingressService, newGateway("public", "")},
+		want: Cluster{Flavor: Kubernetes, Ingress: IngressInfo{
+			Kind: Ingress, Domain: "10.0.0.1.nip.io",
+		}},
+	}, {
+		name:    "setting",
+		objects: []runtime.Object{ingressService},
+		setting: "apps.example.com",
+		want: Cluster{Flavor: Kubernetes, Ingress: IngressInfo{
+			Kind: Ingress, Domain: "apps.example.com",
+		}},
+	}, {
+		name: "not found",
+		want: Cluster{Flavor: Kubernetes, Ingress: IngressInfo{Kind: Ingress}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := o.NewWithT(t)
+			cfg := &config.Config{}
+			if tt.setting != "" {
+				cfg.Installer.Settings = map[string]any{
+					IngressDomainSetting: tt.setting,
+				}
+			}
+			d := NewDiscovery(logger, k8s.NewFakeKube(tt.objects...))
+			c, err := d.Discover(context.TODO(), cfg)
+			g.Expect(err).To(o.Succeed())
+			g.Expect(*c).To(o.Equal(tt.want))
+
+			_, err = c.IngressDomain()
+			if tt.want.Ingress.Domain == "" {
+				g.Expect(err).To(o.MatchError(ErrIngressDomainNotFound))
+			} else {
+				g.Expect(err).To(o.Succeed())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaVersionKey is the configuration attribute, under the application root
+// key, holding the configuration layout version.
+const SchemaVersionKey = "schemaVersion"
+
+// MigrateFn transforms the application node (the mapping under the root key)
+// from the previous schema version into the migration's target version. The
+// node is modified in place, comments are preserved by the yaml.Node structure.
+type MigrateFn func(appNode *yaml.Node) error
+
+// Migration represents a single configuration layout transformation, moving the
+// configuration from "Version-1" to "Version".
+type Migration struct {
+	Version     int       // target schema version
+	Description string    // short description of the changes
+	Migrate     MigrateFn // transformation function
+}
+
+// ErrUnsupportedSchemaVersion the configuration schema version is newer than
+// the versions supported by this executable.
+var ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+
+// migrations registry of configuration migrations, sorted by version.
+var migrations = []Migration{}
+
+// RegisterMigration adds a new migration to the registry. Installers register
+// the migrations for their configuration layout on initialization, the version
+// must be unique, the registry is kept sorted by version.
+func RegisterMigration(m Migration) {
+	for _, existing := range migrations {
+		if existing.Version == m.Version {
+			panic(fmt.Sprintf("duplicate configuration migration: %d", m.Version))
+		}
+	}
+	migrations = append(migrations, m)
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return a.Version - b.Version
+	})
+}
+
+// CurrentSchemaVersion returns the latest configuration schema version known.
+// Configurations without the schema version attribute are assumed version one.
+func CurrentSchemaVersion() int {
+	if len(migrations) == 0 {
+		return 1
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// SchemaVersion returns the configuration schema version, when not informed in
+// the configuration it returns version one, the layout before versioning.
+func (c *Config) SchemaVersion() int {
+	if c.Installer.SchemaVersion == 0 {
+		return 1
+	}
+	return c.Installer.SchemaVersion
+}
+
+// PendingMigrations returns the migrations required to bring the configuration
+// to the current schema version.
+func (c *Config) PendingMigrations() ([]Migration, error) {
+	version := c.SchemaVersion()
+	if version > CurrentSchemaVersion() {
+		return nil, fmt.Errorf("%w: configuration is version %d, latest known is %d",
+			ErrUnsupportedSchemaVersion, version, CurrentSchemaVersion())
+	}
+	pending := []Migration{}
+	for _, m := range migrations {
+		if m.Version > version {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate applies all pending migrations, in order, and stamps the resulting
+// schema version on the configuration. Returns the migrations applied.
+func (c *Config) Migrate() ([]Migration, error) {
+	pending, err := c.PendingMigrations()
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return pending, nil
+	}
+	appNode, err := c.appNode()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pending {
+		if err = m.Migrate(appNode); err != nil {
+			return nil, fmt.Errorf("migrating configuration to version %d (%s): %w",
+				m.Version, m.Description, err)
+		}
+		InsertMappingValue(
+			appNode,
+			SchemaVersionKey,
+			ScalarNode("!!int", strconv.Itoa(m.Version)),
+			"Configuration layout version, managed by the installer.",
+		)
+	}
+	if err = c.DecodeNode(); err != nil {
+		return nil, err
+	}
+	c.ApplyDefaults()
+	return pending, nil
+}
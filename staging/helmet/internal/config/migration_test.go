@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	o "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMigrate(t *testing.T) {
+	g := o.NewWithT(t)
+
+	// Replacing the registry for the test, restoring it afterwards.
+	registered := migrations
+	t.Cleanup(func() { migrations = registered })
+	migrations = []Migration{}
+
+	RegisterMigration(Migration{
+		Version:     3,
+		Description: "rename the \"ci\" setting",
+		Migrate: func(appNode *yaml.Node) error {
+			settings := MappingValue(appNode, "settings")
+			RenameMappingKey(settings, "ci", "pipelines")
+			return nil
+		},
+	})
+	RegisterMigration(Migration{
+		Version:     2,
+		Description: "drop the \"crc\" setting",
+		Migrate: func(appNode *yaml.Node) error {
+			settings := MappingValue(appNode, "settings")
+			DeleteMappingKey(settings, "crc")
+			return nil
+		},
+	})
+	g.Expect(CurrentSchemaVersion()).To(o.Equal(3))
+	g.Expect(func() {
+		RegisterMigration(Migration{Version: 2})
+	}).To(o.Panic())
+
+	payload := []byte(`---
+helmet_ex:
+  settings:
+    # Setting comment.
+    crc: false
+    ci:
+      debug: false
+  products: []
+`)
+	cfg, err := NewConfigFromBytes(payload, "test-namespace", "helmet_ex")
+	g.Expect(err).To(o.Succeed())
+	g.Expect(cfg.SchemaVersion()).To(o.Equal(1))
+
+	pending, err := cfg.PendingMigrations()
+	g.Expect(err).To(o.Succeed())
+	g.Expect(pending).To(o.HaveLen(2))
+	g.Expect(pending[0].Version).To(o.Equal(2))
+
+	applied, err := cfg.Migrate()
+	g.Expect(err).To(o.Succeed())
+	g.Expect(applied).To(o.HaveLen(2))
+	g.Expect(cfg.SchemaVersion()).To(o.Equal(3))
+	g.Expect(cfg.Installer.Settings).To(o.HaveKey("pipelines"))
+	g.Expect(cfg.Installer.Settings).NotTo(o.HaveKey("crc"))
+	// The comment of the deleted key is carried over to the next.
+	g.Expect(cfg.String()).To(o.ContainSubstring(
+		"# Setting comment.\n    pipelines:"))
+
+	pending, err = cfg.PendingMigrations()
+	g.Expect(err).To(o.Succeed())
+	g.Expect(pending).To(o.BeEmpty())
+
+	t.Run("unsupported version", func(t *testing.T) {
+		cfg, err := NewConfigFromBytes([]byte(`---
+helmet_ex:
+  schemaVersion: 4
+  settings: {}
+  products: []
+`), "test-namespace", "helmet_ex")
+		g.Expect(err).To(o.Succeed())
+		_, err = cfg.Migrate()
+		g.Expect(err).To(o.MatchError(ErrUnsupportedSchemaVersion))
+	})
+}
@@ -0,0 +1,256 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FindNode searches for a specific key within a YAML node structure.
+//
+// It traverses the YAML node structure:
+//   - If the node is a DocumentNode, it unwraps to its first content node and
+//     recurses.
+//   - If the node is a MappingNode, it first checks if the key exists directly
+//     within the current mapping. If not found, it recursively searches
+//     within the values of the current mapping.
+//   - For any other node kind, it returns an error.
+//
+// Returns the yaml.Node corresponding to the found key's value, or an error
+// if the key is not found, or the node kind is unsupported.
+func FindNode(node *yaml.Node, key string) (*yaml.Node, error) {
+	current := node
+
+	switch current.Kind {
+	case yaml.DocumentNode:
+		if len(current.Content) == 0 {
+			return nil, fmt.Errorf("empty document")
+		}
+		current = current.Content[0]
+		return FindNode(current, key)
+	case yaml.MappingNode:
+		for i := 0; i < len(current.Content); i += 2 {
+			keyNode := current.Content[i]
+			valueNode := current.Content[i+1]
+			if keyNode.Value == key {
+				return valueNode, nil
+			}
+		}
+		for i := 1; i < len(current.Content); i += 2 {
+			result, err := FindNode(current.Content[i], key)
+			if err == nil && result != nil {
+				return result, nil
+			}
+		}
+		return nil, fmt.Errorf("key %q not found", key)
+	default:
+		return nil, fmt.Errorf("cannot find config: %v", key)
+	}
+}
+
+// UpdateMappingValue updates a key's value within a YAML mapping node.
+//
+// It traverses the YAML node structure:
+//   - If the node is a DocumentNode, it delegates to its first content node.
+//     If the DocumentNode is empty, it creates a root mapping node.
+//   - If the node is a MappingNode, it searches for the specified key.
+//     If found, it marshals the newValue to YAML and unmarshals it back
+//     into a new yaml.Node to preserve type fidelity, then replaces the
+//     existing value node. If the key is not found, it returns nil.
+//   - For any other node kind, it returns an error.
+func UpdateMappingValue(node *yaml.Node, key string, newValue any) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 {
+			return UpdateMappingValue(node.Content[0], key, newValue)
+		}
+		// Create root mapping.
+		mappingNode := &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{}}
+		node.Content = []*yaml.Node{mappingNode}
+		return UpdateMappingValue(mappingNode, key, newValue)
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			// Find existing key.
+			if node.Content[i].Value != key {
+				continue
+			}
+
+			// Preserve anchor on the old value.
+			oldValue := node.Content[i+1]
+
+			// Rebuild value node to preserve types.
+			var doc yaml.Node
+			bs, err := yaml.Marshal(newValue)
+			if err != nil {
+				return err
+			}
+			if err := yaml.Unmarshal(bs, &doc); err != nil {
+				return err
+			}
+			if len(doc.Content) == 0 {
+				return fmt.Errorf("invalid new value for key %q", key)
+			}
+
+			// Replace node and preserve anchor.
+			newValueNode := doc.Content[0]
+			newValueNode.Anchor = oldValue.Anchor
+			node.Content[i+1] = newValueNode
+
+			return nil
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot set value on node kind: %v", node.Kind)
+	}
+}
+
+// UpdateNestedValue updates a value deep within a YAML node structure by
+// traversing a given path of keys. The path of keys represents the hierarchy of
+// the yaml.Node structure.
+//
+// It handles different node kinds and path lengths:
+//   - If the path is empty, it returns an error.
+//   - If the path contains only one key, it delegates to UpdateMappingValue.
+//   - If the node is a DocumentNode, it unwraps to its first content node and
+//     recurses.
+//   - If the node is a MappingNode, it iterates through its content to find the
+//     first key in the path. If found, it recursively calls itself on the
+//     corresponding value node with the rest of the path. If the key is not
+//     found, it returns an error.
+//   - For any other node kind, it returns an error, as navigation is not
+//     possible.
+func UpdateNestedValue(node *yaml.Node, keyPath []string, newValue any) error {
+	if len(keyPath) == 0 {
+		return fmt.Errorf("config path is missing")
+	}
+	if len(keyPath) == 1 {
+		return UpdateMappingValue(node, keyPath[0], newValue)
+	}
+	key := keyPath[0]
+	remainingKeys := keyPath[1:]
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 {
+			return UpdateNestedValue(node.Content[0], keyPath, newValue)
+		}
+		return fmt.Errorf("invalid config content")
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, key) {
+				return UpdateNestedValue(
+					node.Content[i+1], remainingKeys, newValue)
+			}
+		}
+		return fmt.Errorf("key not found: %s", key)
+	case yaml.SequenceNode:
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return fmt.Errorf("invalid array index: %q", key)
+		}
+		if index < 0 || index >= len(node.Content) {
+			return fmt.Errorf("array index out of bounds: %d", index)
+		}
+		return UpdateNestedValue(node.Content[index], remainingKeys, newValue)
+	default:
+		return fmt.Errorf("cannot navigate through node kind: %v", node.Kind)
+	}
+}
+
+// MappingValue returns the value node for the informed key on a mapping node, or
+// nil when the key is not present. Unlike FindNode, only the informed mapping is
+// inspected.
+func MappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// SetMappingValue sets the key on the mapping node with the informed value
+// node. When the key already exists its value node is replaced, keeping the
+// original comments. Otherwise, the key is appended to the mapping with the
+// optional head comment.
+func SetMappingValue(node *yaml.Node, key string, value *yaml.Node, comment string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+		old := node.Content[i+1]
+		value.LineComment = old.LineComment
+		value.FootComment = old.FootComment
+		node.Content[i+1] = value
+		return
+	}
+	node.Content = append(node.Content, keyNode(key, comment), value)
+}
+
+// InsertMappingValue inserts the key and value at the beginning of the mapping
+// node, when the key is not yet present. The comment becomes the head comment
+// of the new key.
+func InsertMappingValue(node *yaml.Node, key string, value *yaml.Node, comment string) {
+	if MappingValue(node, key) != nil {
+		SetMappingValue(node, key, value, comment)
+		return
+	}
+	node.Content = append([]*yaml.Node{keyNode(key, comment), value}, node.Content...)
+}
+
+// RenameMappingKey renames the key on the mapping node, comments and value are
+// kept as is. Returns false when the key is not found.
+func RenameMappingKey(node *yaml.Node, from, to string) bool {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == from {
+			node.Content[i].Value = to
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteMappingKey removes the key, and its value, from the mapping node. The
+// key's head comment is carried over to the next key, if any. Returns false
+// when the key is not found.
+func DeleteMappingKey(node *yaml.Node, key string) bool {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+		if comment := node.Content[i].HeadComment; comment != "" &&
+			i+2 < len(node.Content) && node.Content[i+2].HeadComment == "" {
+			node.Content[i+2].HeadComment = comment
+		}
+		node.Content = append(node.Content[:i], node.Content[i+2:]...)
+		return true
+	}
+	return false
+}
+
+// ScalarNode returns a new scalar node for the informed value and YAML tag,
+// e.g. "!!str", "!!int" or "!!bool".
+func ScalarNode(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+// keyNode returns a new mapping key node with the optional head comment.
+func keyNode(key, comment string) *yaml.Node {
+	return &yaml.Node{
+		Kind:        yaml.ScalarNode,
+		Tag:         "!!str",
+		Value:       key,
+		HeadComment: comment,
+	}
+}
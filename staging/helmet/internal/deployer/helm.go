@@ -0,0 +1,266 @@
+package deployer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/printer"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/resource"
+)
+
+// Helm represents the Helm support for the installer. It's responsible for
+// running the Helm related actions.
+type Helm struct {
+	logger *slog.Logger // application logger
+	out    io.Writer    // release information output
+	flags  *flags.Flags // global flags
+
+	chart     *chart.Chart          // helm chart instance
+	namespace string                // kubernetes namespace
+	actionCfg *action.Configuration // helm action configuration
+
+	release *release.Release // helm chart release
+}
+
+// ErrInstallFailed when the Helm chart installation fails.
+var ErrInstallFailed = errors.New("install failed")
+
+// ErrUpgradeFailed when the Helm chart upgrade fails.
+var ErrUpgradeFailed = errors.New("upgrade failed")
+
+// ErrReleaseNotLoaded when the release isn't deployed, nor loaded, yet.
+var ErrReleaseNotLoaded = errors.New("release not loaded")
+
+// Collector collects the release resources, e.g. the monitor.
+type Collector interface {
+	// Collect collects the release resource.
+	Collect(context.Context, *resource.Info) error
+}
+
+// CollectorFunc adapts the function as a Collector.
+type CollectorFunc func(context.Context, *resource.Info) error
+
+// Collect calls the function.
+func (fn CollectorFunc) Collect(ctx context.Context, r *resource.Info) error {
+	return fn(ctx, r)
+}
+
+// printRelease prints the Helm release information.
+func (h *Helm) printRelease(rel *release.Release) {
+	// In debug mode, print the configuration values using key-value pairs.
+	if !h.flags.DryRun && h.flags.Debug {
+		printer.ValuesPrinter(h.out, "Config", rel.Config)
+	}
+	printer.HelmReleasePrinter(h.out, rel)
+	// Print extended release information only in dry-run or debug mode. This
+	// allows rendering chart templates (dry-run) while inspecting the release
+	// manifests.
+	if h.flags.DryRun || h.flags.Debug {
+		printer.HelmExtendedReleasePrinter(h.out, rel)
+	}
+	printer.HelmReleaseNotesPrinter(h.out, rel)
+}
+
+// releaseName returns the release name, the chart name scoped by the
+// installation instance.
+func (h *Helm) releaseName() string {
+	return h.flags.Scoped(h.chart.Name())
+}
+
+// helmInstall equivalent to "helm install" command.
+func (h *Helm) helmInstall(
+	ctx context.Context,
+	vals chartutil.Values,
+) (*release.Release, error) {
+	c := action.NewInstall(h.actionCfg)
+	c.GenerateName = false
+	c.Namespace = h.namespace
+	c.ReleaseName = h.releaseName()
+	c.Timeout = h.flags.Timeout
+
+	c.DryRun = h.flags.DryRun
+	c.ClientOnly = h.flags.DryRun
+	if h.flags.DryRun {
+		c.DryRunOption = "server"
+	}
+
+	rel, err := c.RunWithContext(ctx, h.chart, vals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInstallFailed, err.Error())
+	}
+	return rel, nil
+}
+
+// helmUpgrade equivalent to "helm upgrade" command.
+func (h *Helm) helmUpgrade(
+	ctx context.Context,
+	vals chartutil.Values,
+) (*release.Release, error) {
+	c := action.NewUpgrade(h.actionCfg)
+	c.Namespace = h.namespace
+	c.Timeout = h.flags.Timeout
+
+	c.DryRun = h.flags.DryRun
+	if h.flags.DryRun {
+		c.DryRunOption = "server"
+	}
+
+	rel, err := c.RunWithContext(ctx, h.releaseName(), h.chart, vals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUpgradeFailed, err.Error())
+	}
+	return rel, err
+}
+
+// Deploy deploys the Helm chart (Dependency) on the cluster. It checks if the
+// release is already installed in order to use the proper helm-client (action).
+func (h *Helm) Deploy(ctx context.Context, vals chartutil.Values) error {
+	c := action.NewHistory(h.actionCfg)
+	c.Max = 1
+
+	h.logger.Debug("Checking if release exists on the cluster")
+	var err error
+	if _, err = c.Run(h.releaseName()); errors.Is(err, driver.ErrReleaseNotFound) {
+		h.logger.Info("Installing Helm Chart...")
+		h.release, err = h.helmInstall(ctx, vals)
+	} else {
+		h.logger.Info("Upgrading Helm Chart...")
+		h.release, err = h.helmUpgrade(ctx, vals)
+	}
+	if err != nil {
+		return err
+	}
+	h.printRelease(h.release)
+	return nil
+}
+
+// Verify equivalent to "helm test", it checks whether the release is correctly
+// deployed by running chart tests and waiting for successful result.
+func (h *Helm) Verify() error {
+	if h.flags.DryRun {
+		h.logger.Debug("Dry-run mode enabled, skipping verification")
+		return nil
+	}
+
+	h.logger.Debug("Verifying the release...")
+	c := action.NewReleaseTesting(h.actionCfg)
+	c.Namespace = h.namespace
+
+	_, err := c.Run(h.releaseName())
+	if err != nil {
+		return err
+	}
+	h.logger.Info("Release verified!")
+	return nil
+}
+
+// VerifyWithRetry attempts to verify the Helm deployment multiple times with a
+// delay between retries.
+func (h *Helm) VerifyWithRetry() error {
+	var err error
+	retries := 3
+	for i := 1; i <= retries; i++ {
+		err = h.Verify()
+		if err == nil || i == retries {
+			break
+		}
+		time.Sleep(time.Minute)
+	}
+	return err
+}
+
+// LoadRelease loads the release deployed on the cluster, to inspect it without
+// deploying, returns "driver.ErrReleaseNotFound" when not deployed.
+func (h *Helm) LoadRelease() error {
+	rel, err := action.NewGet(h.actionCfg).Run(h.releaseName())
+	if err != nil {
+		return err
+	}
+	h.release = rel
+	return nil
+}
+
+// VisitReleaseResources collects the resources created by the Helm chart
+// release, deployed or loaded.
+func (h *Helm) VisitReleaseResources(
+	ctx context.Context,
+	m Collector,
+) error {
+	if h.release == nil {
+		return ErrReleaseNotLoaded
+	}
+	releasedResources, err := h.actionCfg.KubeClient.Build(
+		bytes.NewBufferString(h.release.Manifest), true)
+	if err != nil {
+		return err
+	}
+	return releasedResources.Visit(func(r *resource.Info, err error) error {
+		if err != nil {
+			return err
+		}
+		return m.Collect(ctx, r)
+	})
+}
+
+// GetNotes retrieves the latest release (version 0) of the Helm chart, printing
+// out the notes from the info section.
+func (h *Helm) GetNotes() (string, error) {
+	c := action.NewGet(h.actionCfg)
+	c.Version = 0
+
+	res, err := c.Run(h.releaseName())
+	if err != nil {
+		return "", err
+	}
+	return res.Info.Notes, nil
+}
+
+// NewHelm creates a new Helm instance, setting up the Helm action configuration
+// to be used on subsequent interactions. The Helm instance is bound to a single
+// Helm Chart. The release information is printed on the output.
+func NewHelm(
+	logger *slog.Logger,
+	out io.Writer,
+	f *flags.Flags,
+	kube k8s.Interface,
+	namespace string,
+	chart *chart.Chart,
+) (*Helm, error) {
+	actionCfg, err := k8s.NewHelmActionConfig(logger, kube, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	actionCfg.RegistryClient, err = registry.NewClient(
+		registry.ClientOptDebug(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Helm{
+		logger: logger.With(
+			"type", "helm",
+			"chart", chart.Name(),
+			"namespace", namespace,
+		),
+		out:       out,
+		flags:     f,
+		chart:     chart,
+		namespace: namespace,
+		actionCfg: actionCfg,
+	}, nil
+}
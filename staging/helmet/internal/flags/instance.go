@@ -0,0 +1,45 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// InstanceValue represents the installation instance name as a persistent flag,
+// the name becomes part of resource names and namespaces, thus it must be a valid
+// DNS label.
+type InstanceValue struct {
+	instance *string // shared pointer instance name
+}
+
+var _ pflag.Value = &InstanceValue{}
+
+// Set validates and stores the instance name on the shared pointer.
+func (i *InstanceValue) Set(value string) error {
+	if value != "" {
+		if errs := validation.IsDNS1123Label(value); len(errs) > 0 {
+			return fmt.Errorf("invalid instance name %q: %s",
+				value, strings.Join(errs, ", "))
+		}
+	}
+	*i.instance = value
+	return nil
+}
+
+// String shows the current instance name.
+func (i *InstanceValue) String() string {
+	return *i.instance
+}
+
+// Type shows the persistent flag type.
+func (*InstanceValue) Type() string {
+	return "string"
+}
+
+// NewInstanceValue creates a new instance with the shared instance name pointer.
+func NewInstanceValue(instance *string) *InstanceValue {
+	return &InstanceValue{instance: instance}
+}
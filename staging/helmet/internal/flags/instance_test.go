@@ -0,0 +1,43 @@
+package flags
+
+import (
+	"testing"
+
+	o "github.com/onsi/gomega"
+)
+
+func TestInstanceValue_Set(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance string
+		wantErr  bool
+	}{
+		{name: "default instance", instance: "", wantErr: false},
+		{name: "valid instance", instance: "blue", wantErr: false},
+		{name: "uppercase instance", instance: "Blue", wantErr: true},
+		{name: "dotted instance", instance: "team.a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := o.NewWithT(t)
+			instance := "previous"
+			err := NewInstanceValue(&instance).Set(tt.instance)
+			if tt.wantErr {
+				g.Expect(err).To(o.HaveOccurred())
+				g.Expect(instance).To(o.Equal("previous"))
+				return
+			}
+			g.Expect(err).To(o.Succeed())
+			g.Expect(instance).To(o.Equal(tt.instance))
+		})
+	}
+}
+
+func TestFlags_Scoped(t *testing.T) {
+	g := o.NewWithT(t)
+	f := &Flags{}
+	g.Expect(f.Scoped("tssc")).To(o.Equal("tssc"))
+	f.Instance = "blue"
+	g.Expect(f.Scoped("tssc")).To(o.Equal("tssc-blue"))
+}
@@ -0,0 +1,306 @@
+package githubapp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+
+	"github.com/google/go-github/scrape"
+	"github.com/google/go-github/v80/github"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Requirements the permissions and webhook events the GitHub App must have for
+// the platform services to work.
+type Requirements struct {
+	Permissions map[string]string // permission name and minimum access level
+	Events      []string          // webhook events subscribed
+}
+
+// Spec describes the GitHub App the installer creates, it's informed by the
+// "githubApp" installer setting and the "integration github" flags. The preset
+// is the base, events replace the preset events, and permissions override the
+// preset access levels; "none" removes the permission.
+type Spec struct {
+	// Preset base permissions and events: "full", "pac-only" or "rhdh-only".
+	Preset string `yaml:"preset"`
+	// Public toggles whether the GitHub App can be installed by anyone.
+	Public *bool `yaml:"public"`
+	// Events webhook events the GitHub App subscribes to.
+	Events []string `yaml:"events"`
+	// Permissions access levels by permission name.
+	Permissions map[string]string `yaml:"permissions"`
+}
+
+// Manifest the resolved GitHub App permissions, events and visibility.
+type Manifest struct {
+	Requirements
+
+	Public bool // the app can be installed by anyone
+}
+
+const (
+	// SettingName installer setting with the GitHub App specification.
+	SettingName = "githubApp"
+
+	// PresetFull the permissions for Pipelines-as-Code and Developer Hub.
+	PresetFull = "full"
+	// PresetPACOnly the permissions for Pipelines-as-Code.
+	PresetPACOnly = "pac-only"
+	// PresetRHDHOnly the permissions for Developer Hub.
+	PresetRHDHOnly = "rhdh-only"
+
+	// accessNone access level removing a preset permission.
+	accessNone = "none"
+)
+
+// ErrInvalidSpec the GitHub App specification is invalid.
+var ErrInvalidSpec = errors.New("invalid github app specification")
+
+// accessLevels permission access levels, in ascending order.
+var accessLevels = []string{"read", "write", "admin"}
+
+var (
+	// pacRequirements what Pipelines-as-Code needs to run pipelines on pull
+	// requests and pushes, reporting the results as check runs.
+	pacRequirements = Requirements{
+		Permissions: map[string]string{
+			"checks":            "write",
+			"contents":          "write",
+			"issues":            "write",
+			"members":           "read",
+			"metadata":          "read",
+			"organization_plan": "read",
+			"pull_requests":     "write",
+		},
+		Events: []string{
+			"check_run",
+			"check_suite",
+			"commit_comment",
+			"issue_comment",
+			"pull_request",
+			"push",
+		},
+	}
+
+	// rhdhRequirements what Developer Hub needs to sign users in and to publish
+	// the repositories scaffolded by the software templates.
+	rhdhRequirements = Requirements{
+		Permissions: map[string]string{
+			"administration": "write",
+			"contents":       "write",
+			"members":        "read",
+			"metadata":       "read",
+			"pull_requests":  "write",
+			"workflows":      "write",
+		},
+	}
+
+	// presets the GitHub App presets, by name.
+	presets = map[string]Requirements{
+		PresetFull:     pacRequirements.Merge(rhdhRequirements),
+		PresetPACOnly:  pacRequirements,
+		PresetRHDHOnly: rhdhRequirements,
+	}
+)
+
+// Presets returns the preset names, sorted.
+func Presets() []string {
+	return slices.Sorted(maps.Keys(presets))
+}
+
+// Preset returns the named preset requirements, and whether it exists.
+func Preset(name string) (Requirements, bool) {
+	req, ok := presets[name]
+	return req.Merge(Requirements{}), ok
+}
+
+// Merge returns the requirements combined, keeping the highest access level.
+func (r *Requirements) Merge(other Requirements) Requirements {
+	merged := Requirements{
+		Permissions: maps.Clone(r.Permissions),
+		Events:      slices.Clone(r.Events),
+	}
+	if merged.Permissions == nil {
+		merged.Permissions = map[string]string{}
+	}
+	for name, level := range other.Permissions {
+		if slices.Index(accessLevels, level) >
+			slices.Index(accessLevels, merged.Permissions[name]) {
+			merged.Permissions[name] = level
+		}
+	}
+	for _, event := range other.Events {
+		if !slices.Contains(merged.Events, event) {
+			merged.Events = append(merged.Events, event)
+		}
+	}
+	sort.Strings(merged.Events)
+	return merged
+}
+
+// Missing returns the permissions and events lacking to meet the requirements,
+// for the permissions granted and the events subscribed.
+func (r *Requirements) Missing(granted map[string]string, events []string) []string {
+	var missing []string
+	for name, level := range r.Permissions {
+		if slices.Index(accessLevels, granted[name]) < slices.Index(accessLevels, level) {
+			missing = append(missing, fmt.Sprintf(
+				"permission %q: %q required, %q granted", name, level, granted[name]))
+		}
+	}
+	for _, event := range r.Events {
+		if !slices.Contains(events, event) {
+			missing = append(missing, fmt.Sprintf("event %q not subscribed", event))
+		}
+	}
+	slices.Sort(missing)
+	return missing
+}
+
+// SpecFromSettings decodes the GitHub App specification from the installer
+// setting value, a zero specification is returned when unset.
+func SpecFromSettings(value any) (*Spec, error) {
+	spec := &Spec{}
+	if value == nil {
+		return spec, nil
+	}
+	payload, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(payload, spec); err != nil {
+		return nil, fmt.Errorf("%w: %q setting: %w", ErrInvalidSpec, SettingName, err)
+	}
+	return spec, nil
+}
+
+// Manifest resolves the specification. The "full" preset and a public app are
+// the defaults.
+func (s *Spec) Manifest() (*Manifest, error) {
+	preset := s.Preset
+	if preset == "" {
+		preset = PresetFull
+	}
+	base, ok := Preset(preset)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown preset %q, expected one of %v",
+			ErrInvalidSpec, preset, Presets())
+	}
+	m := &Manifest{Requirements: base, Public: true}
+	if s.Public != nil {
+		m.Public = *s.Public
+	}
+	if s.Events != nil {
+		m.Events = slices.Sorted(slices.Values(s.Events))
+	}
+	for name, level := range s.Permissions {
+		switch {
+		case !knownPermission(name):
+			return nil, fmt.Errorf("%w: unknown permission %q", ErrInvalidSpec, name)
+		case level == accessNone:
+			delete(m.Permissions, name)
+		case slices.Contains(accessLevels, level):
+			m.Permissions[name] = level
+		default:
+			return nil, fmt.Errorf("%w: permission %q: invalid access level %q",
+				ErrInvalidSpec, name, level)
+		}
+	}
+	return m, nil
+}
+
+// knownPermission asserts the permission name is supported by the GitHub API.
+func knownPermission(name string) bool {
+	t := reflect.TypeOf(github.InstallationPermissions{})
+	for i := range t.NumField() {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Missing returns what the manifest lacks to meet the requirements.
+func (m *Manifest) Missing(req *Requirements) []string {
+	return req.Missing(m.Permissions, m.Events)
+}
+
+// AppManifest generates the GitHub App manifest, the webhook is only active
+// when the app subscribes to events.
+func (m *Manifest) AppManifest(
+	name, description, homepageURL, callbackURL, webhookURL string,
+) (*scrape.AppManifest, error) {
+	payload, err := json.Marshal(m.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	permissions := &github.InstallationPermissions{}
+	if err = json.Unmarshal(payload, permissions); err != nil {
+		return nil, err
+	}
+	manifest := &scrape.AppManifest{
+		Name:               github.Ptr(name),
+		URL:                github.Ptr(homepageURL),
+		Description:        github.Ptr(description),
+		Public:             github.Ptr(m.Public),
+		DefaultEvents:      m.Events,
+		DefaultPermissions: permissions,
+	}
+	if callbackURL != "" {
+		manifest.CallbackURLs = []string{callbackURL}
+	}
+	if len(m.Events) > 0 {
+		manifest.HookAttributes = map[string]string{"url": webhookURL}
+	}
+	return manifest, nil
+}
+
+// ManifestFlags adds the flags overriding the GitHub App specification setting.
+func ManifestFlags(p *pflag.FlagSet) {
+	p.String("preset", "", fmt.Sprintf(
+		"GitHub App permissions and events preset %v", Presets()))
+	p.Bool("public", true, "GitHub App can be installed by anyone")
+	p.StringSlice("events", nil,
+		"GitHub App webhook events, replacing the preset events")
+	p.StringToString("permission", nil,
+		"GitHub App permission access level (read, write, admin or none), "+
+			"overriding the preset, e.g. administration=none")
+}
+
+// ResolveManifest resolves the GitHub App manifest from the installer setting,
+// and the flags informed, added by ManifestFlags. A preset informed on the
+// command line replaces the setting.
+func ResolveManifest(cfg *config.Config, f *pflag.FlagSet) (*Manifest, error) {
+	spec, err := SpecFromSettings(cfg.Installer.Settings[SettingName])
+	if err != nil {
+		return nil, err
+	}
+	if f.Changed("preset") {
+		spec.Preset, _ = f.GetString("preset")
+		spec.Events, spec.Permissions = nil, nil
+	}
+	if f.Changed("public") {
+		public, _ := f.GetBool("public")
+		spec.Public = &public
+	}
+	if f.Changed("events") {
+		spec.Events, _ = f.GetStringSlice("events")
+	}
+	if f.Changed("permission") {
+		permissions, _ := f.GetStringToString("permission")
+		if spec.Permissions == nil {
+			spec.Permissions = map[string]string{}
+		}
+		maps.Copy(spec.Permissions, permissions)
+	}
+	return spec.Manifest()
+}
@@ -0,0 +1,279 @@
+package githubapp
+
+import (
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+
+	o "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+func TestPresets(t *testing.T) {
+	g := o.NewWithT(t)
+
+	g.Expect(Presets()).To(o.Equal(
+		[]string{PresetFull, PresetPACOnly, PresetRHDHOnly}))
+
+	t.Run("full", func(t *testing.T) {
+		full, ok := Preset(PresetFull)
+		g.Expect(ok).To(o.BeTrue())
+		// Every preset is part of the full preset.
+		for _, name := range []string{PresetPACOnly, PresetRHDHOnly} {
+			preset, _ := Preset(name)
+			g.Expect(preset.Missing(full.Permissions, full.Events)).
+				To(o.BeEmpty(), name)
+		}
+		g.Expect(full.Permissions).To(o.HaveKeyWithValue("administration", "write"))
+		g.Expect(full.Permissions).To(o.HaveKeyWithValue("checks", "write"))
+		g.Expect(full.Events).To(o.ContainElement("pull_request"))
+	})
+
+	t.Run("pac-only", func(t *testing.T) {
+		pac, ok := Preset(PresetPACOnly)
+		g.Expect(ok).To(o.BeTrue())
+		g.Expect(pac.Permissions).NotTo(o.HaveKey("administration"))
+		g.Expect(pac.Events).To(o.ContainElement("push"))
+	})
+
+	t.Run("rhdh-only", func(t *testing.T) {
+		rhdh, ok := Preset(PresetRHDHOnly)
+		g.Expect(ok).To(o.BeTrue())
+		g.Expect(rhdh.Permissions).To(o.HaveKeyWithValue("workflows", "write"))
+		g.Expect(rhdh.Events).To(o.BeEmpty())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, ok := Preset("unknown")
+		g.Expect(ok).To(o.BeFalse())
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		// Changing the returned requirements leaves the preset intact.
+		pac, _ := Preset(PresetPACOnly)
+		pac.Permissions["checks"] = "read"
+		pac.Events[0] = "changed"
+		again, _ := Preset(PresetPACOnly)
+		g.Expect(again.Permissions).To(o.HaveKeyWithValue("checks", "write"))
+		g.Expect(again.Events).NotTo(o.ContainElement("changed"))
+	})
+}
+
+func TestRequirements_Merge(t *testing.T) {
+	g := o.NewWithT(t)
+
+	a := Requirements{
+		Permissions: map[string]string{"contents": "read", "checks": "write"},
+		Events:      []string{"push"},
+	}
+	merged := a.Merge(Requirements{
+		Permissions: map[string]string{"contents": "write", "checks": "read"},
+		Events:      []string{"pull_request", "push"},
+	})
+	g.Expect(merged.Permissions).To(o.Equal(
+		map[string]string{"contents": "write", "checks": "write"}))
+	g.Expect(merged.Events).To(o.Equal([]string{"pull_request", "push"}))
+	// The receiver is left as is.
+	g.Expect(a.Permissions).To(o.HaveKeyWithValue("contents", "read"))
+}
+
+func TestRequirements_Missing(t *testing.T) {
+	req := Requirements{
+		Permissions: map[string]string{"contents": "write", "metadata": "read"},
+		Events:      []string{"pull_request", "push"},
+	}
+	tests := []struct {
+		name    string            // test case name
+		granted map[string]string // permissions granted
+		events  []string          // events subscribed
+		want    []string          // missing expected
+	}{{
+		name:    "met",
+		granted: map[string]string{"contents": "write", "metadata": "read"},
+		events:  []string{"pull_request", "push"},
+	}, {
+		name: "higher access levels",
+		granted: map[string]string{
+			"contents": "admin", "metadata": "write", "issues": "write",
+		},
+		events: []string{"issues", "pull_request", "push"},
+	}, {
+		name:    "lower access level",
+		granted: map[string]string{"contents": "read", "metadata": "read"},
+		events:  []string{"pull_request", "push"},
+		want:    []string{`permission "contents": "write" required, "read" granted`},
+	}, {
+		name:   "nothing granted",
+		events: []string{"push"},
+		want: []string{
+			`event "pull_request" not subscribed`,
+			`permission "contents": "write" required, "" granted`,
+			`permission "metadata": "read" required, "" granted`,
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := o.NewWithT(t)
+			g.Expect(req.Missing(tt.granted, tt.events)).To(o.Equal(tt.want))
+		})
+	}
+}
+
+func TestSpec_Manifest(t *testing.T) {
+	pac, _ := Preset(PresetPACOnly)
+	full, _ := Preset(PresetFull)
+
+	tests := []struct {
+		name    string         // test case name
+		setting any            // "githubApp" setting value
+		check   func(*o.WithT, *Manifest)
+		wantErr bool // error expected
+	}{{
+		name: "defaults",
+		check: func(g *o.WithT, m *Manifest) {
+			g.Expect(m.Public).To(o.BeTrue())
+			g.Expect(m.Requirements).To(o.Equal(full))
+		},
+	}, {
+		name:    "preset",
+		setting: map[string]any{"preset": "pac-only", "public": false},
+		check: func(g *o.WithT, m *Manifest) {
+			g.Expect(m.Public).To(o.BeFalse())
+			g.Expect(m.Requirements).To(o.Equal(pac))
+		},
+	}, {
+		name: "permissions and events",
+		setting: map[string]any{
+			"events": []any{"push"},
+			"permissions": map[string]any{
+				"administration": "none",
+				"contents":       "read",
+			},
+		},
+		check: func(g *o.WithT, m *Manifest) {
+			g.Expect(m.Events).To(o.Equal([]string{"push"}))
+			g.Expect(m.Permissions).NotTo(o.HaveKey("administration"))
+			g.Expect(m.Permissions).To(o.HaveKeyWithValue("contents", "read"))
+		},
+	}, {
+		name:    "unknown preset",
+		setting: map[string]any{"preset": "unknown"},
+		wantErr: true,
+	}, {
+		name:    "unknown permission",
+		setting: map[string]any{"permissions": map[string]any{"unknown": "read"}},
+		wantErr: true,
+	}, {
+		name:    "invalid access level",
+		setting: map[string]any{"permissions": map[string]any{"contents": "all"}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := o.NewWithT(t)
+			spec, err := SpecFromSettings(tt.setting)
+			g.Expect(err).To(o.Succeed())
+			m, err := spec.Manifest()
+			if tt.wantErr {
+				g.Expect(err).To(o.MatchError(ErrInvalidSpec))
+				return
+			}
+			g.Expect(err).To(o.Succeed())
+			tt.check(g, m)
+		})
+	}
+}
+
+func TestManifest_Missing(t *testing.T) {
+	g := o.NewWithT(t)
+
+	spec := &Spec{Preset: PresetPACOnly}
+	m, err := spec.Manifest()
+	g.Expect(err).To(o.Succeed())
+
+	rhdh, _ := Preset(PresetRHDHOnly)
+	g.Expect(m.Missing(&rhdh)).To(o.ContainElement(
+		`permission "administration": "write" required, "" granted`))
+	pac, _ := Preset(PresetPACOnly)
+	g.Expect(m.Missing(&pac)).To(o.BeEmpty())
+}
+
+func TestManifest_AppManifest(t *testing.T) {
+	g := o.NewWithT(t)
+
+	rhdh, _ := (&Spec{Preset: PresetRHDHOnly}).Manifest()
+	manifest, err := rhdh.AppManifest("app", "description",
+		"https://home", "https://callback", "https://webhook")
+	g.Expect(err).To(o.Succeed())
+	g.Expect(*manifest.Name).To(o.Equal("app"))
+	g.Expect(manifest.CallbackURLs).To(o.Equal([]string{"https://callback"}))
+	g.Expect(manifest.DefaultPermissions.GetWorkflows()).To(o.Equal("write"))
+	// Without events the webhook is left inactive.
+	g.Expect(manifest.HookAttributes).To(o.BeEmpty())
+
+	pac, _ := (&Spec{Preset: PresetPACOnly}).Manifest()
+	manifest, err = pac.AppManifest("app", "", "https://home", "", "https://webhook")
+	g.Expect(err).To(o.Succeed())
+	g.Expect(manifest.CallbackURLs).To(o.BeEmpty())
+	g.Expect(manifest.HookAttributes).To(
+		o.HaveKeyWithValue("url", "https://webhook"))
+}
+
+func TestResolveManifest(t *testing.T) {
+	cfg, err := config.NewConfigFromBytes([]byte(`
+helmet_ex:
+  settings:
+    githubApp:
+      preset: rhdh-only
+      public: false
+      permissions:
+        administration: none
+  products: []
+`), "installer-ns", "helmet_ex")
+	if err != nil {
+		t.Fatalf("build config: %v", err)
+	}
+
+	tests := []struct {
+		name  string   // test case name
+		args  []string // command line flags
+		check func(*o.WithT, *Manifest)
+	}{{
+		name: "setting",
+		check: func(g *o.WithT, m *Manifest) {
+			g.Expect(m.Public).To(o.BeFalse())
+			g.Expect(m.Permissions).NotTo(o.HaveKey("administration"))
+			g.Expect(m.Permissions).To(o.HaveKeyWithValue("workflows", "write"))
+		},
+	}, {
+		name: "preset replaces the setting",
+		args: []string{"--preset=pac-only"},
+		check: func(g *o.WithT, m *Manifest) {
+			pac, _ := Preset(PresetPACOnly)
+			g.Expect(m.Requirements).To(o.Equal(pac))
+			g.Expect(m.Public).To(o.BeFalse())
+		},
+	}, {
+		name: "flags override the setting",
+		args: []string{
+			"--public", "--events=push", "--permission=contents=read",
+		},
+		check: func(g *o.WithT, m *Manifest) {
+			g.Expect(m.Public).To(o.BeTrue())
+			g.Expect(m.Events).To(o.Equal([]string{"push"}))
+			g.Expect(m.Permissions).To(o.HaveKeyWithValue("contents", "read"))
+			g.Expect(m.Permissions).NotTo(o.HaveKey("administration"))
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := o.NewWithT(t)
+			f := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			ManifestFlags(f)
+			g.Expect(f.Parse(tt.args)).To(o.Succeed())
+			m, err := ResolveManifest(cfg, f)
+			g.Expect(err).To(o.Succeed())
+			tt.check(g, m)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/redhat-appstudio/helmet/api/integrations"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+)
+
+// PrintEndpoints writes the endpoints the integration declares, resolved by the
+// provider, skipping the empty ones. These are the URLs the user configures on
+// the external service.
+func PrintEndpoints(
+	ctx context.Context,
+	w io.Writer,
+	name string,
+	provider integrations.URLProvider,
+	runCtx *runcontext.RunContext,
+	cfg *config.Config,
+) error {
+	adapter := newURLProviderAdapter(provider, runCtx, cfg)
+	endpoints := []struct {
+		name string
+		fn   func(context.Context, *runcontext.RunContext, *config.Config) (string, error)
+	}{
+		{"Authentication callback URL", adapter.GetCallbackURL},
+		{"Homepage URL", adapter.GetHomepageURL},
+		{"Webhook URL", adapter.GetWebhookURL},
+	}
+	urls := make([]string, len(endpoints))
+	for i, e := range endpoints {
+		url, err := e.fn(ctx, runCtx, cfg)
+		if err != nil {
+			return fmt.Errorf("resolving the %s: %w", e.name, err)
+		}
+		urls[i] = url
+	}
+
+	fmt.Fprintf(w, "#\n# Endpoints for the %q integration:\n", name)
+	for i, e := range endpoints {
+		if urls[i] != "" {
+			fmt.Fprintf(w, "#   %s: %s\n", e.name, urls[i])
+		}
+	}
+	fmt.Fprintln(w, "#")
+	return nil
+}
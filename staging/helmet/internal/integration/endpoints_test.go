@@ -0,0 +1,26 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	o "github.com/onsi/gomega"
+)
+
+func TestPrintEndpoints(t *testing.T) {
+	g := o.NewWithT(t)
+	var out bytes.Buffer
+	err := PrintEndpoints(context.TODO(), &out, "bitbucket",
+		&mockPublicURLProvider{
+			homepageURL: "https://home.example.com",
+			webhookURL:  "https://webhook.example.com",
+		}, nil, nil)
+	g.Expect(err).To(o.Succeed())
+	g.Expect(out.String()).To(o.Equal(`#
+# Endpoints for the "bitbucket" integration:
+#   Homepage URL: https://home.example.com
+#   Webhook URL: https://webhook.example.com
+#
+`))
+}
@@ -0,0 +1,284 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Integration represents a generic Kubernetes Secret manager for integrations, it
+// holds the common actions integrations will perform against secrets.
+type Integration struct {
+	logger *slog.Logger           // application logger
+	runCtx *runcontext.RunContext // run context, the kubernetes client
+	name   string                 // kubernetes secret name
+	data   Interface              // provides secret data
+	writer SecretWriter           // writes the secret instead of storing it, optional
+
+	force bool // overwrite the existing secret
+}
+
+var (
+	// ErrSecretAlreadyExists integration secret already exists.
+	ErrSecretAlreadyExists = fmt.Errorf("secret already exists")
+	// ErrSecretNotFound integration secret is not found.
+	ErrSecretNotFound = fmt.Errorf("secret not found")
+)
+
+// PersistentFlags decorates the cobra instance with persistent flags.
+func (i *Integration) PersistentFlags(cmd *cobra.Command) {
+	p := cmd.PersistentFlags()
+
+	p.BoolVar(&i.force, "force", i.force, "Overwrite the existing secret")
+
+	// Decorating the command with integration data flags.
+	i.data.PersistentFlags(cmd)
+}
+
+// SetArgument exposes the data provider method.
+func (i *Integration) SetArgument(k, v string) error {
+	return i.data.SetArgument(k, v)
+}
+
+// SetSecretWriter sets the writer for the integration secret, when enabled for
+// it the secret is written instead of stored in the cluster.
+func (i *Integration) SetSecretWriter(w SecretWriter) {
+	i.writer = w
+}
+
+// SecretName the integration secret name.
+func (i *Integration) SecretName() string {
+	return i.name
+}
+
+// Writing asserts whether the secret writer is enabled for the integration, the
+// integration secret is written instead of stored in the cluster.
+func (i *Integration) Writing() bool {
+	return i.writer != nil && i.writer.Enabled(i.name)
+}
+
+// Validate validates the secret payload, using the data interface.
+func (i *Integration) Validate() error {
+	return i.data.Validate()
+}
+
+// log returns a logger decorated with secret and data attributes.
+func (i *Integration) log() *slog.Logger {
+	return i.data.LoggerWith(i.logger.With(
+		"secret-name", i.name,
+		"secret-type", i.data.Type(),
+	))
+}
+
+// secretName generates the namespaced name for the integration secret.
+func (i *Integration) secretName(cfg *config.Config) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: cfg.Namespace(),
+		Name:      i.name,
+	}
+}
+
+// Exists checks whether the integration secret exists in the cluster.
+func (i *Integration) Exists(
+	ctx context.Context,
+	cfg *config.Config,
+) (bool, error) {
+	return k8s.SecretExists(ctx, i.runCtx.Kube, i.secretName(cfg))
+}
+
+// Get returns the integration secret stored in the cluster, nil when the
+// integration isn't configured.
+func (i *Integration) Get(
+	ctx context.Context,
+	cfg *config.Config,
+) (*corev1.Secret, error) {
+	secret, err := k8s.GetSecret(ctx, i.runCtx.Kube, i.secretName(cfg))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return secret, err
+}
+
+// prepare prepares the cluster to receive the integration secret, when the force
+// flag is enabled an existing secret is deleted.
+func (i *Integration) prepare(ctx context.Context, cfg *config.Config) error {
+	i.log().Debug("Checking whether the integration secret exists")
+	exists, err := i.Exists(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		i.log().Debug("Integration secret does not exist")
+		return nil
+	}
+	if !i.force {
+		i.log().Debug("Integration secret already exists")
+		return fmt.Errorf("%w: %s",
+			ErrSecretAlreadyExists, i.secretName(cfg).String())
+	}
+	i.log().Debug("Integration secret already exists, recreating it")
+	return i.Delete(ctx, cfg)
+}
+
+// Secret generates the integration secret, using the integration data provider
+// to obtain the secret payload.
+func (i *Integration) Secret(
+	ctx context.Context,
+	runCtx *runcontext.RunContext,
+	cfg *config.Config,
+) (*corev1.Secret, error) {
+	// The integration provider prepares and returns the payload to create the
+	// Kubernetes secret.
+	i.log().Debug("Preparing the integration secret payload")
+	payload, err := i.data.Data(ctx, runCtx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return i.newSecret(cfg, i.data.Type(), payload), nil
+}
+
+// newSecret instantiates the integration secret with the payload.
+func (i *Integration) newSecret(
+	cfg *config.Config,
+	secretType corev1.SecretType,
+	payload map[string][]byte,
+) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.secretName(cfg).Namespace,
+			Name:      i.name,
+		},
+		Type: secretType,
+		Data: payload,
+	}
+}
+
+// create stores the integration secret in the cluster.
+func (i *Integration) create(ctx context.Context, secret *corev1.Secret) error {
+	i.log().Debug("Creating the integration secret")
+	coreClient, err := i.runCtx.Kube.CoreV1ClientSet(secret.Namespace)
+	if err != nil {
+		return err
+	}
+	_, err = coreClient.Secrets(secret.Namespace).
+		Create(ctx, secret, metav1.CreateOptions{})
+	if err == nil {
+		i.log().Info("Integration secret is created successfully!")
+	}
+	return err
+}
+
+// Create creates the integration secret in the cluster. It uses the integration
+// data provider to obtain the secret payload. When the secret writer is enabled
+// for the integration, the secret is written instead, the cluster is left as is.
+func (i *Integration) Create(ctx context.Context, runCtx *runcontext.RunContext, cfg *config.Config) error {
+	if i.Writing() {
+		secret, err := i.Secret(ctx, runCtx, cfg)
+		if err != nil {
+			return err
+		}
+		i.log().Debug("Writing the integration secret")
+		return i.writer.Write(ctx, secret)
+	}
+
+	err := i.prepare(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	secret, err := i.Secret(ctx, runCtx, cfg)
+	if err != nil {
+		return err
+	}
+	return i.create(ctx, secret)
+}
+
+// Store stores the informed payload as the integration secret, instead of the
+// data provider's, for integrations configured by other means, e.g. an existing
+// application imported. Like Create, the secret writer is honored, and an
+// existing secret is only replaced with "--force".
+func (i *Integration) Store(
+	ctx context.Context,
+	cfg *config.Config,
+	payload map[string][]byte,
+) error {
+	secret := i.newSecret(cfg, i.data.Type(), payload)
+	if i.Writing() {
+		i.log().Debug("Writing the integration secret")
+		return i.writer.Write(ctx, secret)
+	}
+	if err := i.prepare(ctx, cfg); err != nil {
+		return err
+	}
+	return i.create(ctx, secret)
+}
+
+// Update updates the informed keys of the integration secret stored in the
+// cluster, keeping the others, e.g. to rotate credentials. When the secret writer
+// is enabled for the integration, the updated secret is written instead.
+func (i *Integration) Update(
+	ctx context.Context,
+	cfg *config.Config,
+	payload map[string][]byte,
+) error {
+	secret, err := i.Get(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, i.secretName(cfg).String())
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for k, v := range payload {
+		secret.Data[k] = v
+	}
+	if i.Writing() {
+		i.log().Debug("Writing the updated integration secret")
+		return i.writer.Write(ctx, i.newSecret(cfg, secret.Type, secret.Data))
+	}
+
+	i.log().Debug("Updating the integration secret")
+	coreClient, err := i.runCtx.Kube.CoreV1ClientSet(secret.Namespace)
+	if err != nil {
+		return err
+	}
+	_, err = coreClient.Secrets(secret.Namespace).
+		Update(ctx, secret, metav1.UpdateOptions{})
+	if err == nil {
+		i.log().Info("Integration secret is updated successfully!")
+	}
+	return err
+}
+
+// Delete deletes the Kubernetes secret.
+func (i *Integration) Delete(ctx context.Context, cfg *config.Config) error {
+	return k8s.DeleteSecret(ctx, i.runCtx.Kube, i.secretName(cfg))
+}
+
+// NewSecret instantiates a new secret manager, it uses the integration data
+// provider to generate the Kubernetes Secret payload. The Kubernetes client is
+// read from the run context, the client may be replaced before running.
+func NewSecret(
+	runCtx *runcontext.RunContext,
+	name string,
+	data Interface,
+) *Integration {
+	return &Integration{
+		logger: runCtx.Logger,
+		runCtx: runCtx,
+		name:   name,
+		data:   data,
+	}
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/api/integrations"
+	"github.com/redhat-appstudio/helmet/internal/cluster"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// integrationContext implements integrations.IntegrationContext from the run
+// context and the installer configuration, the cluster is discovered once.
+type integrationContext struct {
+	runCtx  *runcontext.RunContext // framework run context
+	cfg     *config.Config         // installer configuration
+	cluster *cluster.Cluster       // discovered cluster attributes, lazy
+}
+
+var _ integrations.IntegrationContext = (*integrationContext)(nil)
+
+// hostLookups the resources exposing services, and the path to the host
+// attribute, or to a list where the first entry is the host.
+var hostLookups = []struct {
+	gvr  schema.GroupVersionResource
+	path []string
+}{{
+	gvr: schema.GroupVersionResource{
+		Group: "route.openshift.io", Version: "v1", Resource: "routes",
+	},
+	path: []string{"spec", "host"},
+}, {
+	gvr: schema.GroupVersionResource{
+		Group: "networking.k8s.io", Version: "v1", Resource: "ingresses",
+	},
+	path: []string{"spec", "rules"},
+}, {
+	gvr: schema.GroupVersionResource{
+		Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes",
+	},
+	path: []string{"spec", "hostnames"},
+}}
+
+// GetOpenShiftIngressDomain implements integrations.IntegrationContext.
+func (c *integrationContext) GetOpenShiftIngressDomain(ctx context.Context) (string, error) {
+	return k8s.GetOpenShiftIngressDomain(ctx, c.runCtx.Kube)
+}
+
+// GetProductNamespace implements integrations.IntegrationContext.
+func (c *integrationContext) GetProductNamespace(productName string) (string, error) {
+	product, err := c.cfg.GetProduct(productName)
+	if err != nil {
+		return "", err
+	}
+	return product.GetNamespace(), nil
+}
+
+// GetConfig implements integrations.IntegrationContext.
+func (c *integrationContext) GetConfig() *config.Config {
+	return c.cfg
+}
+
+// GetCluster implements integrations.IntegrationContext.
+func (c *integrationContext) GetCluster(ctx context.Context) (*cluster.Cluster, error) {
+	if c.cluster != nil {
+		return c.cluster, nil
+	}
+	cl, err := cluster.NewDiscovery(c.runCtx.Logger, c.runCtx.Kube).
+		Discover(ctx, c.cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.cluster = cl
+	return cl, nil
+}
+
+// lookupHost reads the host from the resource, following the path.
+func (c *integrationContext) lookupHost(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	namespace, name string,
+	path ...string,
+) (string, error) {
+	client, err := c.runCtx.Kube.DynamicClient(namespace)
+	if err != nil {
+		return "", err
+	}
+	obj, err := client.Resource(gvr).Namespace(namespace).
+		Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		// Missing resources, and APIs not served by the cluster.
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if host, found, _ := unstructured.NestedString(obj.Object, path...); found {
+		return host, nil
+	}
+	hosts, _, _ := unstructured.NestedSlice(obj.Object, path...)
+	for _, h := range hosts {
+		switch v := h.(type) {
+		case string:
+			return v, nil
+		case map[string]any:
+			if host, _ := v["host"].(string); host != "" {
+				return host, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// GetHost implements integrations.IntegrationContext.
+func (c *integrationContext) GetHost(
+	ctx context.Context,
+	namespace, name string,
+) (string, error) {
+	for _, l := range hostLookups {
+		host, err := c.lookupHost(ctx, l.gvr, namespace, name, l.path...)
+		if err != nil {
+			return "", err
+		}
+		if host != "" {
+			return host, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s/%s", integrations.ErrHostNotFound, namespace, name)
+}
+
+// NewIntegrationContext instantiates the IntegrationContext URL providers are
+// called with, backed by the run context and the installer configuration.
+func NewIntegrationContext(
+	runCtx *runcontext.RunContext,
+	cfg *config.Config,
+) integrations.IntegrationContext {
+	return &integrationContext{runCtx: runCtx, cfg: cfg}
+}
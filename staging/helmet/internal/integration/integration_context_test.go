@@ -0,0 +1,109 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api/integrations"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// exposing returns the unstructured object exposing a service on the namespace.
+func exposing(apiVersion, kind, namespace, name string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]any{"namespace": namespace, "name": name},
+		"spec":       spec,
+	}}
+}
+
+func newTestIntegrationContext(
+	t *testing.T,
+	cfg *config.Config,
+	objects ...runtime.Object,
+) integrations.IntegrationContext {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	runCtx := runcontext.NewRunContext(
+		k8s.NewFakeKube(objects...), nil, flags.NewFlags(), logger, io.Discard)
+	return NewIntegrationContext(runCtx, cfg)
+}
+
+func Test_integrationContext_GetHost(t *testing.T) {
+	t.Parallel()
+	ic := newTestIntegrationContext(t, nil,
+		exposing("route.openshift.io/v1", "Route", "ns", "route",
+			map[string]any{"host": "route.example.com"}),
+		exposing("networking.k8s.io/v1", "Ingress", "ns", "ingress",
+			map[string]any{"rules": []any{
+				map[string]any{"host": "ingress.example.com"},
+			}}),
+		exposing("gateway.networking.k8s.io/v1", "HTTPRoute", "ns", "httproute",
+			map[string]any{"hostnames": []any{"httproute.example.com"}}),
+	)
+
+	tests := []struct {
+		name    string // service name
+		want    string // host expected
+		wantErr error  // error expected
+	}{
+		{name: "route", want: "route.example.com"},
+		{name: "ingress", want: "ingress.example.com"},
+		{name: "httproute", want: "httproute.example.com"},
+		{name: "missing", wantErr: integrations.ErrHostNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ic.GetHost(context.Background(), "ns", tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetHost: got err %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetHost: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_integrationContext_GetCluster(t *testing.T) {
+	t.Parallel()
+	cfg, err := config.NewConfigFromBytes([]byte(`
+helmet_ex:
+  settings:
+    ingressDomain: apps.example.com
+  products: []
+`), "installer-ns", "helmet_ex")
+	if err != nil {
+		t.Fatalf("build config: %v", err)
+	}
+	ic := newTestIntegrationContext(t, cfg)
+
+	if ic.GetConfig() != cfg {
+		t.Error("GetConfig: the informed configuration is expected")
+	}
+	cl, err := ic.GetCluster(context.Background())
+	if err != nil {
+		t.Fatalf("GetCluster: %v", err)
+	}
+	domain, err := cl.IngressDomain()
+	if err != nil {
+		t.Fatalf("IngressDomain: %v", err)
+	}
+	if domain != "apps.example.com" {
+		t.Errorf("IngressDomain: got %q, want %q", domain, "apps.example.com")
+	}
+	again, _ := ic.GetCluster(context.Background())
+	if again != cl {
+		t.Error("GetCluster: the cluster is expected to be discovered once")
+	}
+}
@@ -0,0 +1,174 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	o "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// testWriter records the secrets written, enabled for the informed name.
+type testWriter struct {
+	name    string
+	written []*corev1.Secret
+}
+
+func (w *testWriter) Enabled(name string) bool {
+	return name == w.name
+}
+
+func (w *testWriter) Write(_ context.Context, secret *corev1.Secret) error {
+	w.written = append(w.written, secret)
+	return nil
+}
+
+// clusterKube serves the core objects from a single clientset, keeping the
+// writes, unlike the fake client.
+type clusterKube struct {
+	*k8s.FakeKube
+	cs *fake.Clientset
+}
+
+func (c *clusterKube) CoreV1ClientSet(string) (corev1client.CoreV1Interface, error) {
+	return c.cs.CoreV1(), nil
+}
+
+func TestIntegration_Create(t *testing.T) {
+	g := o.NewWithT(t)
+
+	cfs := chartfs.New(os.DirFS("../../test"))
+	cfg, err := config.NewConfigFromFile(
+		cfs, "config.yaml", "test-namespace", "helmet_ex")
+	g.Expect(err).To(o.Succeed())
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	secretName := types.NamespacedName{
+		Namespace: "test-namespace",
+		Name:      "helmet-quay-integration",
+	}
+
+	// The fake cluster already holds the integration secret.
+	existing := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: secretName.Namespace,
+		Name:      secretName.Name,
+	}}
+	runCtx := runcontext.NewRunContext(
+		k8s.NewFakeKube(existing), nil, flags.NewFlags(), logger, io.Discard)
+
+	t.Run("stored", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, secretName.Name,
+			NewContainerRegistry("https://quay.io"))
+		i.SetSecretWriter(&testWriter{name: "helmet-nexus-integration"})
+
+		g.Expect(i.Create(context.TODO(), nil, cfg)).
+			To(o.MatchError(ErrSecretAlreadyExists))
+	})
+
+	t.Run("written", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, secretName.Name,
+			NewContainerRegistry("https://quay.io"))
+		w := &testWriter{name: secretName.Name}
+		i.SetSecretWriter(w)
+
+		// The cluster is left as is, the existing secret doesn't matter.
+		g.Expect(i.Create(context.TODO(), nil, cfg)).To(o.Succeed())
+		g.Expect(w.written).To(o.HaveLen(1))
+		g.Expect(w.written[0].Namespace).To(o.Equal(secretName.Namespace))
+		g.Expect(w.written[0].Name).To(o.Equal(secretName.Name))
+		g.Expect(w.written[0].Data).To(o.HaveKeyWithValue(
+			"url", []byte("https://quay.io")))
+	})
+}
+
+func TestIntegration_Store(t *testing.T) {
+	g := o.NewWithT(t)
+
+	cfs := chartfs.New(os.DirFS("../../test"))
+	cfg, err := config.NewConfigFromFile(
+		cfs, "config.yaml", "test-namespace", "helmet_ex")
+	g.Expect(err).To(o.Succeed())
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	secretName := "helmet-github-integration"
+	kube := &clusterKube{FakeKube: k8s.NewFakeKube(), cs: fake.NewClientset()}
+	runCtx := runcontext.NewRunContext(
+		kube, nil, flags.NewFlags(), logger, io.Discard)
+
+	t.Run("stored", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, secretName, NewContainerRegistry(""))
+		i.SetSecretWriter(&testWriter{name: "helmet-nexus-integration"})
+
+		g.Expect(i.Store(context.TODO(), cfg, map[string][]byte{
+			"id": []byte("1"), "pem": []byte("key"),
+		})).To(o.Succeed())
+		secret, err := i.Get(context.TODO(), cfg)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(secret.Type).To(o.Equal(i.data.Type()))
+		g.Expect(secret.Data).To(o.HaveKeyWithValue("pem", []byte("key")))
+
+		// Storing again requires "--force".
+		g.Expect(i.Store(context.TODO(), cfg, nil)).
+			To(o.MatchError(ErrSecretAlreadyExists))
+	})
+
+	t.Run("updated", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, secretName, NewContainerRegistry(""))
+
+		g.Expect(i.Update(context.TODO(), cfg, map[string][]byte{
+			"pem": []byte("rotated"),
+		})).To(o.Succeed())
+		secret, err := i.Get(context.TODO(), cfg)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(secret.Data).To(o.Equal(map[string][]byte{
+			"id": []byte("1"), "pem": []byte("rotated"),
+		}))
+	})
+
+	t.Run("written", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, secretName, NewContainerRegistry(""))
+		w := &testWriter{name: secretName}
+		i.SetSecretWriter(w)
+
+		// The stored secret is kept, the updated secret is written.
+		g.Expect(i.Update(context.TODO(), cfg, map[string][]byte{
+			"pem": []byte("written"),
+		})).To(o.Succeed())
+		g.Expect(w.written).To(o.HaveLen(1))
+		g.Expect(w.written[0].ResourceVersion).To(o.BeEmpty())
+		g.Expect(w.written[0].Data).To(o.HaveKeyWithValue(
+			"pem", []byte("written")))
+		secret, err := i.Get(context.TODO(), cfg)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(secret.Data).To(o.HaveKeyWithValue("pem", []byte("rotated")))
+
+		g.Expect(i.Store(context.TODO(), cfg, nil)).To(o.Succeed())
+		g.Expect(w.written).To(o.HaveLen(2))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		g := o.NewWithT(t)
+		i := NewSecret(runCtx, "helmet-quay-integration", NewContainerRegistry(""))
+		secret, err := i.Get(context.TODO(), cfg)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(secret).To(o.BeNil())
+		g.Expect(i.Update(context.TODO(), cfg, nil)).
+			To(o.MatchError(ErrSecretNotFound))
+	})
+}
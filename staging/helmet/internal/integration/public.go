@@ -0,0 +1,39 @@
+package integration
+
+import "slices"
+
+// publicKeys the integration secret data keys holding non-sensitive values, such
+// as URLs, hostnames, organizations and identifiers.
+var publicKeys = []string{
+	"baseUrl",
+	"bombastic_api_url",
+	"clientId",
+	"createdAt",
+	"endpoint",
+	"externalURL",
+	"group",
+	"host",
+	"htmlURL",
+	"id",
+	"name",
+	"nodeId",
+	"oidc_issuer_url",
+	"organization",
+	"ownerId",
+	"ownerLogin",
+	"port",
+	"rekor_url",
+	"slug",
+	"supported_cyclonedx_version",
+	"tenantId",
+	"tuf_url",
+	"updatedAt",
+	"url",
+	"username",
+}
+
+// IsPublicKey tells whether the integration secret data key holds a
+// non-sensitive value, safe to show.
+func IsPublicKey(key string) bool {
+	return slices.Contains(publicKeys, key)
+}
@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/redhat-appstudio/helmet/api/integrations"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+)
+
+// urlProviderAdapter implements integration.URLProvider by delegating to
+// integrations.URLProvider, with the integrationContext backed by
+// *runcontext.RunContext and *config.Config. Used when wiring a public
+// URLProvider into the GitHub integration (e.g. in setClusterURLs).
+type urlProviderAdapter struct {
+	*integrationContext
+
+	provider integrations.URLProvider
+}
+
+// Ensure urlProviderAdapter implements both interfaces at compile time.
+var (
+	_ integrations.IntegrationContext = (*urlProviderAdapter)(nil)
+	_ URLProvider                     = (*urlProviderAdapter)(nil)
+)
+
+// GetCallbackURL implements URLProvider by delegating to the public provider.
+func (a *urlProviderAdapter) GetCallbackURL(ctx context.Context, _ *runcontext.RunContext, _ *config.Config) (string, error) {
+	return a.provider.GetCallbackURL(ctx, a.integrationContext)
+}
+
+// GetHomepageURL implements URLProvider by delegating to the public provider.
+func (a *urlProviderAdapter) GetHomepageURL(ctx context.Context, _ *runcontext.RunContext, _ *config.Config) (string, error) {
+	return a.provider.GetHomepageURL(ctx, a.integrationContext)
+}
+
+// GetWebhookURL implements URLProvider by delegating to the public provider.
+func (a *urlProviderAdapter) GetWebhookURL(ctx context.Context, _ *runcontext.RunContext, _ *config.Config) (string, error) {
+	return a.provider.GetWebhookURL(ctx, a.integrationContext)
+}
+
+// newURLProviderAdapter returns an adapter that implements URLProvider by
+// delegating to the given integrations.URLProvider with an IntegrationContext
+// backed by runCtx and cfg. runCtx and cfg must be non-nil when the provider
+// calls IntegrationContext methods (e.g. GetOpenShiftIngressDomain, GetProductNamespace).
+func newURLProviderAdapter(provider integrations.URLProvider, runCtx *runcontext.RunContext, cfg *config.Config) *urlProviderAdapter {
+	return &urlProviderAdapter{
+		integrationContext: &integrationContext{runCtx: runCtx, cfg: cfg},
+		provider:           provider,
+	}
+}
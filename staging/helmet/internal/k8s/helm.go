@@ -0,0 +1,32 @@
+package k8s
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"helm.sh/helm/v3/pkg/action"
+)
+
+// NewHelmActionConfig returns the Helm action configuration for the namespace,
+// using the storage driver informed by "HELM_DRIVER".
+func NewHelmActionConfig(
+	logger *slog.Logger,
+	kube Interface,
+	namespace string,
+) (*action.Configuration, error) {
+	actionCfg := new(action.Configuration)
+	loggerFn := func(format string, v ...interface{}) {
+		logger.WithGroup("helm-cli").Debug(fmt.Sprintf(format, v...))
+	}
+	err := actionCfg.Init(
+		kube.RESTClientGetter(namespace),
+		namespace,
+		os.Getenv("HELM_DRIVER"),
+		loggerFn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return actionCfg, nil
+}
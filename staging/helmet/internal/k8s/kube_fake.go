@@ -0,0 +1,296 @@
+package k8s
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	batchv1client "k8s.io/client-go/kubernetes/typed/batch/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	rbacv1client "k8s.io/client-go/kubernetes/typed/rbac/v1"
+	"k8s.io/client-go/testing"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+)
+
+// FakeKube the Kubernetes client backed by in-memory objects, for tests and
+// rendering without a cluster. The objects of built-in kinds are served by the
+// clientset, every object is served by the dynamic client, the discovery and the
+// REST client getter, the latter backs the Helm "lookup" function.
+type FakeKube struct {
+	objects   []*unstructured.Unstructured                 // served objects
+	resources map[schema.GroupVersion][]metav1.APIResource // discovery
+}
+
+var _ Interface = &FakeKube{}
+
+func (f *FakeKube) BatchV1ClientSet(
+	namespace string,
+) (batchv1client.BatchV1Interface, error) {
+	cs, err := f.ClientSet(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cs.BatchV1(), nil
+}
+
+func (f *FakeKube) ClientSet(string) (kubernetes.Interface, error) {
+	cs := fake.NewSimpleClientset(f.typedObjects()...)
+	cs.Resources = f.resourceLists()
+
+	// Add reactor to automatically set namespace status to Active when created
+	cs.PrependReactor(
+		"create",
+		"namespaces",
+		func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+			createAction := action.(testing.CreateAction)
+			obj := createAction.GetObject()
+			if ns, ok := obj.(*corev1.Namespace); ok {
+				ns.Status.Phase = corev1.NamespaceActive
+			}
+			return false, obj, nil
+		})
+
+	return cs, nil
+}
+
+func (f *FakeKube) Connected() error {
+	return nil
+}
+
+func (f *FakeKube) CoreV1ClientSet(
+	namespace string,
+) (corev1client.CoreV1Interface, error) {
+	cs, err := f.ClientSet(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cs.CoreV1(), nil
+}
+
+func (f *FakeKube) DiscoveryClient(
+	namespace string,
+) (discovery.DiscoveryInterface, error) {
+	cs, err := f.ClientSet(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cs.Discovery(), nil
+}
+
+func (f *FakeKube) DynamicClient(string) (dynamic.Interface, error) {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for gv, resources := range f.resources {
+		for _, r := range resources {
+			listKinds[gv.WithResource(r.Name)] = r.Kind + "List"
+		}
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), listKinds)
+	// Storing the objects by their discovered resource, the tracker would guess
+	// the resource name from the kind instead.
+	for _, obj := range f.objects {
+		gvr, _ := f.resourceFor(obj.GroupVersionKind())
+		if err := client.Tracker().Create(
+			gvr, obj.DeepCopy(), obj.GetNamespace()); err != nil {
+			return nil, err
+		}
+	}
+	return &fakeDynamic{FakeDynamicClient: client, listKinds: listKinds}, nil
+}
+
+// fakeDynamic the dynamic fake client, the resources not discovered are not
+// found, as on a cluster not serving the API, instead of panicking on lists.
+type fakeDynamic struct {
+	*dynamicfake.FakeDynamicClient
+	listKinds map[schema.GroupVersionResource]string // discovered resources
+}
+
+func (d *fakeDynamic) Resource(
+	gvr schema.GroupVersionResource,
+) dynamic.NamespaceableResourceInterface {
+	if _, found := d.listKinds[gvr]; found {
+		return d.FakeDynamicClient.Resource(gvr)
+	}
+	return &notServedResource{
+		NamespaceableResourceInterface: d.FakeDynamicClient.Resource(gvr),
+		gvr:                            gvr,
+	}
+}
+
+// notServedResource a resource the fake cluster doesn't serve.
+type notServedResource struct {
+	dynamic.NamespaceableResourceInterface
+	gvr schema.GroupVersionResource
+}
+
+func (r *notServedResource) Namespace(string) dynamic.ResourceInterface {
+	return r
+}
+
+func (r *notServedResource) Get(
+	context.Context, string, metav1.GetOptions, ...string,
+) (*unstructured.Unstructured, error) {
+	return nil, apierrors.NewNotFound(r.gvr.GroupResource(), "")
+}
+
+func (r *notServedResource) List(
+	context.Context, metav1.ListOptions,
+) (*unstructured.UnstructuredList, error) {
+	return nil, apierrors.NewNotFound(r.gvr.GroupResource(), "")
+}
+
+// GetDynamicClientForObjectRef returns the dynamic client for the object kind,
+// kinds absent from the fake objects are not found.
+func (f *FakeKube) GetDynamicClientForObjectRef(
+	objectRef *corev1.ObjectReference,
+) (dynamic.ResourceInterface, error) {
+	dynamicClient, err := f.DynamicClient(objectRef.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	gvr, _ := f.resourceFor(objectRef.GroupVersionKind())
+	if objectRef.Namespace != "" {
+		return dynamicClient.Resource(gvr).Namespace(objectRef.Namespace), nil
+	}
+	return dynamicClient.Resource(gvr), nil
+}
+
+func (f *FakeKube) RBACV1ClientSet(
+	namespace string,
+) (rbacv1client.RbacV1Interface, error) {
+	cs, err := f.ClientSet(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cs.RbacV1(), nil
+}
+
+// RESTClientGetter returns a getter whose REST configuration is served by the
+// fake objects, in-process, for clients built from it, as Helm does.
+func (f *FakeKube) RESTClientGetter(_ string) genericclioptions.RESTClientGetter {
+	tf := cmdtesting.NewTestFactory()
+	tf.ClientConfigVal.Transport = &fakeTransport{kube: f}
+	return tf
+}
+
+// resourceFor returns the resource of the kind, registered when the objects are
+// added, or guessed from the kind otherwise.
+func (f *FakeKube) resourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool) {
+	for _, r := range f.resources[gvk.GroupVersion()] {
+		if r.Kind == gvk.Kind {
+			return gvk.GroupVersion().WithResource(r.Name), true
+		}
+	}
+	return guessResource(gvk), false
+}
+
+// guessResource guesses the plural resource name for the kind, kinds ending on
+// a vowel followed by "y", like "Gateway", are pluralized with "s".
+func guessResource(gvk schema.GroupVersionKind) schema.GroupVersionResource {
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	if name := plural.Resource; len(name) > 4 && name[len(name)-3:] == "ies" &&
+		slices.Contains([]byte("aeiou"), name[len(name)-4]) {
+		plural.Resource = name[:len(name)-3] + "ys"
+	}
+	return plural
+}
+
+// resourceLists returns the discovery resources, by group version.
+func (f *FakeKube) resourceLists() []*metav1.APIResourceList {
+	lists := []*metav1.APIResourceList{}
+	for gv, resources := range f.resources {
+		lists = append(lists, &metav1.APIResourceList{
+			GroupVersion: gv.String(),
+			APIResources: resources,
+		})
+	}
+	slices.SortFunc(lists, func(a, b *metav1.APIResourceList) int {
+		return strings.Compare(a.GroupVersion, b.GroupVersion)
+	})
+	return lists
+}
+
+// add registers the object, replacing a previous object with the same kind,
+// namespace and name.
+func (f *FakeKube) add(obj runtime.Object) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+		if err != nil {
+			panic(err)
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			panic(err)
+		}
+		u = &unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(gvks[0])
+	}
+	gvk := u.GroupVersionKind()
+	if _, found := f.resourceFor(gvk); !found {
+		gvr := guessResource(gvk)
+		f.resources[gvk.GroupVersion()] = append(
+			f.resources[gvk.GroupVersion()], metav1.APIResource{
+				Name:         gvr.Resource,
+				SingularName: strings.ToLower(gvk.Kind),
+				Kind:         gvk.Kind,
+				Namespaced:   u.GetNamespace() != "",
+				Verbs:        metav1.Verbs{"get", "list"},
+			})
+	}
+	f.objects = slices.DeleteFunc(f.objects, func(o *unstructured.Unstructured) bool {
+		return o.GroupVersionKind() == gvk &&
+			o.GetNamespace() == u.GetNamespace() && o.GetName() == u.GetName()
+	})
+	f.objects = append(f.objects, u)
+}
+
+// typedObjects returns the objects of the kinds known to the clientset, typed.
+// Objects not converting to their type are only served by the dynamic client.
+func (f *FakeKube) typedObjects() []runtime.Object {
+	objects := []runtime.Object{}
+	for _, u := range f.objects {
+		typed, err := scheme.Scheme.New(u.GroupVersionKind())
+		if err != nil {
+			continue
+		}
+		if err = runtime.DefaultUnstructuredConverter.
+			FromUnstructured(u.Object, typed); err != nil {
+			continue
+		}
+		objects = append(objects, typed)
+	}
+	return objects
+}
+
+// NewFakeKube instantiates the fake client with the informed typed, or
+// unstructured, objects. Later objects replace earlier ones with the same kind,
+// namespace and name.
+func NewFakeKube(objects ...runtime.Object) *FakeKube {
+	f := &FakeKube{
+		objects:   []*unstructured.Unstructured{},
+		resources: map[schema.GroupVersion][]metav1.APIResource{},
+	}
+	for _, obj := range objects {
+		// Set Status.Phase to Active for any Namespace objects that don't have it set
+		if ns, ok := obj.(*corev1.Namespace); ok && ns.Status.Phase == "" {
+			ns.Status.Phase = corev1.NamespaceActive
+		}
+		f.add(obj)
+	}
+	return f
+}
@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/test/stubs"
+
+	o "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+func TestFakeKube(t *testing.T) {
+	ctx := context.TODO()
+	gatewayGVR := schema.GroupVersionResource{
+		Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways",
+	}
+	configMap := func(value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":      "config",
+				"namespace": "default",
+				"labels":    map[string]any{"app": "test"},
+			},
+			"data": map[string]any{"key": value},
+		}}
+	}
+	kube := NewFakeKube(
+		stubs.NamespaceRuntimeObject("default"),
+		configMap("first"),
+		configMap("second"),
+		&unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "Gateway",
+			"metadata":   map[string]any{"name": "public", "namespace": "default"},
+		}},
+	)
+
+	t.Run("ClientSet", func(t *testing.T) {
+		g := o.NewWithT(t)
+		cs, err := kube.ClientSet("default")
+		g.Expect(err).To(o.Succeed())
+		// Later objects replace earlier ones, unstructured built-in kinds are
+		// served typed.
+		cm, err := cs.CoreV1().ConfigMaps("default").
+			Get(ctx, "config", metav1.GetOptions{})
+		g.Expect(err).To(o.Succeed())
+		g.Expect(cm.Data).To(o.HaveKeyWithValue("key", "second"))
+
+		groups, err := cs.Discovery().ServerGroups()
+		g.Expect(err).To(o.Succeed())
+		g.Expect(groups.Groups).To(o.ContainElement(
+			o.HaveField("Name", "gateway.networking.k8s.io")))
+	})
+
+	t.Run("DynamicClient", func(t *testing.T) {
+		g := o.NewWithT(t)
+		client, err := kube.DynamicClient("default")
+		g.Expect(err).To(o.Succeed())
+		gateways, err := client.Resource(gatewayGVR).List(ctx, metav1.ListOptions{})
+		g.Expect(err).To(o.Succeed())
+		g.Expect(gateways.Items).To(o.HaveLen(1))
+
+		_, err = client.Resource(schema.GroupVersionResource{
+			Group: "route.openshift.io", Version: "v1", Resource: "routes",
+		}).List(ctx, metav1.ListOptions{})
+		g.Expect(err).To(o.MatchError(o.ContainSubstring("not found")))
+	})
+
+	t.Run("RESTClientGetter", func(t *testing.T) {
+		g := o.NewWithT(t)
+		restConfig, err := kube.RESTClientGetter("default").ToRESTConfig()
+		g.Expect(err).To(o.Succeed())
+
+		dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+		g.Expect(err).To(o.Succeed())
+		resources, err := dc.ServerResourcesForGroupVersion("v1")
+		g.Expect(err).To(o.Succeed())
+		g.Expect(resources.APIResources).To(o.ContainElement(
+			o.HaveField("Name", "configmaps")))
+		// Unknown group versions are served without resources.
+		resources, err = dc.ServerResourcesForGroupVersion("route.openshift.io/v1")
+		g.Expect(err).To(o.Succeed())
+		g.Expect(resources.APIResources).To(o.BeEmpty())
+
+		client, err := dynamic.NewForConfig(restConfig)
+		g.Expect(err).To(o.Succeed())
+		gw, err := client.Resource(gatewayGVR).Namespace("default").
+			Get(ctx, "public", metav1.GetOptions{})
+		g.Expect(err).To(o.Succeed())
+		g.Expect(gw.GetName()).To(o.Equal("public"))
+
+		configMaps, err := client.Resource(schema.GroupVersionResource{
+			Version: "v1", Resource: "configmaps",
+		}).Namespace("default").List(ctx, metav1.ListOptions{
+			LabelSelector: "app=test",
+		})
+		g.Expect(err).To(o.Succeed())
+		g.Expect(configMaps.Items).To(o.HaveLen(1))
+
+		err = client.Resource(gatewayGVR).Namespace("default").
+			Delete(ctx, "public", metav1.DeleteOptions{})
+		g.Expect(err).To(o.HaveOccurred())
+	})
+}
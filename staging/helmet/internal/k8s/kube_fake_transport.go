@@ -0,0 +1,166 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// fakeTransport serves the Kubernetes API requests in-process, from the fake
+// objects: the discovery, and the objects get and list. Other requests are
+// refused, the fake is read-only over the REST configuration.
+type fakeTransport struct {
+	kube *FakeKube // fake client, the objects served
+}
+
+var _ http.RoundTripper = &fakeTransport{}
+
+// writeObject responds with the object as JSON.
+func writeObject(w http.ResponseWriter, code int, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+// writeStatus responds with the API error status.
+func writeStatus(w http.ResponseWriter, err *apierrors.StatusError) {
+	status := err.ErrStatus
+	status.Kind, status.APIVersion = "Status", "v1"
+	writeObject(w, int(status.Code), status)
+}
+
+// serveDiscovery serves the API groups and resources discovery. Unknown group
+// versions are served without resources, so lookups of kinds absent return
+// empty, instead of failing. Helm then requests the object by name right under
+// "/api", as the kind resource is unknown, thus not found.
+func (t *fakeTransport) serveDiscovery(w http.ResponseWriter, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "api":
+		writeObject(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions", APIVersion: "v1"},
+			Versions: []string{"v1"},
+		})
+	case len(segments) == 1:
+		list := &metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+		}
+		for _, r := range t.kube.resourceLists() {
+			gv, _ := schema.ParseGroupVersion(r.GroupVersion)
+			if gv.Group == "" {
+				continue
+			}
+			version := metav1.GroupVersionForDiscovery{
+				GroupVersion: gv.String(), Version: gv.Version,
+			}
+			i := slices.IndexFunc(list.Groups, func(g metav1.APIGroup) bool {
+				return g.Name == gv.Group
+			})
+			if i < 0 {
+				list.Groups = append(list.Groups, metav1.APIGroup{
+					Name: gv.Group, PreferredVersion: version,
+				})
+				i = len(list.Groups) - 1
+			}
+			list.Groups[i].Versions = append(list.Groups[i].Versions, version)
+		}
+		writeObject(w, http.StatusOK, list)
+	case len(segments) == 2 && (segments[0] == "apis" || segments[1] != "v1"):
+		writeStatus(w, apierrors.NewNotFound(
+			schema.GroupResource{}, strings.Join(segments, "/")))
+	default:
+		gv := schema.GroupVersion{Version: segments[len(segments)-1]}
+		if len(segments) == 3 {
+			gv.Group = segments[1]
+		}
+		writeObject(w, http.StatusOK, &metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: gv.String(),
+			APIResources: t.kube.resources[gv],
+		})
+	}
+}
+
+// serveObjects serves the get and list requests, the path segments start on the
+// resource, or on the namespace.
+func (t *fakeTransport) serveObjects(
+	w http.ResponseWriter,
+	r *http.Request,
+	gv schema.GroupVersion,
+	segments []string,
+) {
+	namespace := ""
+	if len(segments) >= 3 && segments[0] == "namespaces" {
+		namespace, segments = segments[1], segments[2:]
+	}
+	gvr := gv.WithResource(segments[0])
+	if len(segments) > 2 || !slices.ContainsFunc(
+		t.kube.resources[gv], func(r metav1.APIResource) bool {
+			return r.Name == gvr.Resource
+		}) {
+		writeStatus(w, apierrors.NewNotFound(
+			gvr.GroupResource(), strings.Join(segments, "/")))
+		return
+	}
+	client, err := t.kube.DynamicClient(namespace)
+	if err != nil {
+		writeStatus(w, apierrors.NewInternalError(err))
+		return
+	}
+	resource := client.Resource(gvr).Namespace(namespace)
+
+	var obj runtime.Object
+	if len(segments) == 2 {
+		obj, err = resource.Get(r.Context(), segments[1], metav1.GetOptions{})
+	} else {
+		obj, err = resource.List(r.Context(), metav1.ListOptions{
+			LabelSelector: r.URL.Query().Get("labelSelector"),
+		})
+	}
+	if err != nil {
+		if status, ok := err.(*apierrors.StatusError); ok {
+			writeStatus(w, status)
+			return
+		}
+		writeStatus(w, apierrors.NewInternalError(err))
+		return
+	}
+	writeObject(w, http.StatusOK, obj)
+}
+
+// RoundTrip serves the request in-process.
+func (t *fakeTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/version":
+		writeObject(w, http.StatusOK, &version.Info{
+			Major: "1", Minor: "31", GitVersion: "v1.31.0",
+		})
+	case r.Method != http.MethodGet || r.URL.Query().Get("watch") == "true":
+		writeStatus(w, apierrors.NewMethodNotSupported(
+			schema.GroupResource{Resource: r.URL.Path}, r.Method))
+	case segments[0] == "api" && len(segments) <= 2,
+		segments[0] == "apis" && len(segments) <= 3:
+		t.serveDiscovery(w, segments)
+	case segments[0] == "api":
+		t.serveObjects(w, r, schema.GroupVersion{Version: segments[1]},
+			segments[2:])
+	case segments[0] == "apis":
+		t.serveObjects(w, r,
+			schema.GroupVersion{Group: segments[1], Version: segments[2]},
+			segments[3:])
+	default:
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+	}
+	res := w.Result()
+	res.Request = r
+	return res, nil
+}
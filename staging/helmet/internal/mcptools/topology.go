@@ -0,0 +1,114 @@
+package mcptools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/resolver"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TopologyTool represents the MCP tool that's responsible to report
+// the dependency topology of the installer based on the cluster
+// configuration and Helm charts.
+type TopologyTool struct {
+	appName string                    // application name
+	cfs     *chartfs.ChartFS          // embedded filesystem
+	cm      *config.ConfigMapManager  // cluster configuration
+	tb      *resolver.TopologyBuilder // topology builder
+}
+
+const (
+	// topologySuffix mcp topology tool name suffix.
+	topologySuffix = "_topology"
+
+	// ChartArg the chart to explain the provenance of.
+	ChartArg = "chart"
+)
+
+// topologyHandler shows a table of the topology.
+func (t *TopologyTool) topologyHandler(
+	ctx context.Context,
+	ctr mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	// Load the installer configuration from the cluster.
+	cfg, err := t.cm.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Building the topology, the same deploy uses, ensuring all dependencies,
+	// chart conditions and integrations are resolved.
+	topology, err := t.tb.Build(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	// Explaining why the chart is installed, or skipped, instead of the table.
+	if name, _ := ctr.GetArguments()[ChartArg].(string); name != "" {
+		err = topology.Explain(&buf, t.tb.GetCollection(), name)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(`
+Unable to explain the chart provenance!`,
+				err,
+			), nil
+		}
+		return mcp.NewToolResultText(buf.String()), nil
+	}
+	topology.Print(&buf)
+
+	return mcp.NewToolResultText(fmt.Sprintf(`
+The topology is a table with following columns:
+
+  - Index: the index of the chart in the dependency graph.
+  - Dependency: the name of the Helm chart.
+  - Namespace: the OpenShift namespace where the chart is installed.
+  - Product: the name of the product the chart is associated with.
+  - Depends-On: comma-separated list of charts the chart depends on.
+  - Provided-Integrations: comma-separated integrations provided by the chart.
+  - Required-Integrations: CEL expressions with the required integrations.
+
+---
+%s`,
+		buf.String())), nil
+}
+
+func (t *TopologyTool) Init(s *server.MCPServer) {
+	s.AddTools([]server.ServerTool{{
+		Tool: mcp.NewTool(
+			t.appName+topologySuffix,
+			mcp.WithDescription(`
+Report the dependency topology of the installer based on the
+cluster configuration and installer dependencies (Helm charts).
+			`),
+			mcp.WithString(
+				ChartArg,
+				mcp.Description(`
+Explains why the informed chart is installed, or skipped, down to the enabled
+products, instead of reporting the whole topology.`,
+				),
+			),
+		),
+		Handler: t.topologyHandler,
+	}}...)
+}
+
+// NewTopologyTool instantiates a new TopologyTool.
+func NewTopologyTool(
+	appName string,
+	cfs *chartfs.ChartFS,
+	cm *config.ConfigMapManager,
+	tb *resolver.TopologyBuilder,
+) *TopologyTool {
+	return &TopologyTool{
+		appName: appName,
+		cfs:     cfs,
+		cm:      cm,
+		tb:      tb,
+	}
+}
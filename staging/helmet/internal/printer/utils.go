@@ -0,0 +1,35 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func valuesToProperties(
+	vals map[string]interface{},
+	path string,
+	sb *strings.Builder,
+) {
+	for k, v := range vals {
+		newPath := k
+		if path != "" {
+			newPath = path + "." + k
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			valuesToProperties(v, newPath, sb)
+		default:
+			fmt.Fprintf(sb, "%s: %v\n", newPath, v)
+		}
+	}
+}
+
+func printProperties(w io.Writer, sb *strings.Builder, prefix string) {
+	lines := strings.Split(sb.String(), "\n")
+	for i, line := range lines {
+		if i < len(lines)-1 {
+			fmt.Fprintf(w, "%s%s\n", prefix, line)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/redhat-appstudio/helmet/internal/config"
+)
+
+// CEL variables exposing the installer configuration to the expressions, besides
+// the integration names.
+const (
+	// SettingsVariable the configuration settings, e.g. "settings.crc".
+	SettingsVariable = "settings"
+	// ProductsVariable the configuration products by key name, with "name",
+	// "enabled", "namespace" and "properties", e.g. "products.Product_A.enabled".
+	ProductsVariable = "products"
+)
+
+// CEL represents the CEL environment with provided integration names, the
+// integrations present in the cluster are represented by a map of integration
+// name and boolean, indicating the integration is configured in the cluster.
+// The installer configuration is available as the "settings" and "products"
+// variables.
+type CEL struct {
+	env          *cel.Env               // all known integrations names, and configuration
+	integrations []string               // all known integrations names
+	config       map[string]any         // configuration variables
+	programs     map[string]cel.Program // compiled expressions cache
+}
+
+var (
+	// ErrInvalidExpression the expression is not a valid CEL expression.
+	ErrInvalidExpression = errors.New("invalid CEL expression")
+	// ErrMissingIntegrations one or more integrations aren't configured.
+	ErrMissingIntegrations = errors.New("missing integrations")
+)
+
+// SetConfig exposes the installer configuration to the expressions, the
+// settings, and the products by key name, the same key the values template uses.
+func (c *CEL) SetConfig(cfg *config.Config) {
+	settings := map[string]any{}
+	for k, v := range cfg.Installer.Settings {
+		settings[k] = v
+	}
+	products := map[string]any{}
+	for _, p := range cfg.Installer.Products {
+		properties := map[string]any{}
+		for k, v := range p.Properties {
+			properties[k] = v
+		}
+		products[p.KeyName()] = map[string]any{
+			"name":       p.Name,
+			"enabled":    p.Enabled,
+			"namespace":  p.GetNamespace(),
+			"properties": properties,
+		}
+	}
+	c.config = map[string]any{
+		SettingsVariable: settings,
+		ProductsVariable: products,
+	}
+}
+
+// compile compiles and type-checks the expression.
+func (c *CEL) compile(expression string) (*cel.Ast, error) {
+	ast, issues := c.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %q: %w",
+			ErrInvalidExpression, expression, issues.Err())
+	}
+	return ast, nil
+}
+
+// Referenced returns the integration names referenced by the expression, the
+// configuration variables are not part of it.
+func (c *CEL) Referenced(expression string) ([]string, error) {
+	ast, err := c.compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	referenced := []string{}
+	for _, ref := range ast.NativeRep().ReferenceMap() {
+		if slices.Contains(c.integrations, ref.Name) &&
+			!slices.Contains(referenced, ref.Name) {
+			referenced = append(referenced, ref.Name)
+		}
+	}
+	slices.Sort(referenced)
+	return referenced, nil
+}
+
+// program returns the evaluable program for the expression, compiled once.
+func (c *CEL) program(expression string) (cel.Program, error) {
+	if prg, ok := c.programs[expression]; ok {
+		return prg, nil
+	}
+	ast, err := c.compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	prg, err := c.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q fails to compile: %w",
+			ErrInvalidExpression, expression, err)
+	}
+	c.programs[expression] = prg
+	return prg, nil
+}
+
+// Satisfied evaluates the provided CEL expression against the configured
+// integrations and the installer configuration, the expression must result in a
+// boolean. Integrations absent from the map are not configured.
+func (c *CEL) Satisfied(
+	configured map[string]bool,
+	expression string,
+) (bool, error) {
+	prg, err := c.program(expression)
+	if err != nil {
+		return false, err
+	}
+	evalContext := map[string]any{
+		SettingsVariable: map[string]any{},
+		ProductsVariable: map[string]any{},
+	}
+	for k, v := range c.config {
+		evalContext[k] = v
+	}
+	for _, name := range c.integrations {
+		evalContext[name] = configured[name]
+	}
+	result, _, err := prg.Eval(evalContext)
+	if err != nil {
+		return false, fmt.Errorf("%w: %q: %w",
+			ErrInvalidExpression, expression, err)
+	}
+	satisfied, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %q: not a boolean, %v",
+			ErrInvalidExpression, expression, result.Value())
+	}
+	return satisfied, nil
+}
+
+// Evaluate evaluates the provided CEL expression against the current context of
+// integration names and a boolean indicating whether it's configured. When not
+// satisfied, the error lists the referenced integrations not configured.
+func (c *CEL) Evaluate(configured map[string]bool, expression string) error {
+	satisfied, err := c.Satisfied(configured, expression)
+	if err != nil {
+		return err
+	}
+	// All expressions must evaluate to true, meaning all required integrations
+	// are configured.
+	if satisfied {
+		return nil
+	}
+
+	// Using the referenced integration names to determine which integrations are
+	// missing, as in should be configured in the cluster but aren't found.
+	referenced, err := c.Referenced(expression)
+	if err != nil {
+		return err
+	}
+	missing := []string{}
+	for _, ref := range referenced {
+		if !configured[ref] {
+			missing = append(missing, ref)
+		}
+	}
+	return fmt.Errorf("%w: %s",
+		ErrMissingIntegrations, strings.Join(missing, ", "))
+}
+
+// NewCEL creates a new CEL instance with the all valid integration names. These
+// names are considered variables in the CEL expression, limiting the scope of the
+// expression to only valid integrations, besides the configuration variables.
+func NewCEL(integrationNames ...string) (*CEL, error) {
+	// Registering the configuration variables, and all integration names as
+	// boolean variables.
+	options := []cel.EnvOption{
+		cel.Variable(SettingsVariable, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(ProductsVariable, cel.MapType(cel.StringType, cel.DynType)),
+	}
+	for _, option := range integrationNames {
+		options = append(options, cel.Variable(option, cel.BoolType))
+	}
+	// Creating a CEL environment using the integration names as options.
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &CEL{
+		env:          env,
+		integrations: slices.Clone(integrationNames),
+		programs:     map[string]cel.Program{},
+	}, nil
+}
@@ -0,0 +1,120 @@
+package resolver
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+)
+
+func TestCEL_Evaluate(t *testing.T) {
+	c, err := NewCEL("a", "b", "c")
+	if err != nil {
+		t.Errorf("NewCEL() failed: %v", err)
+		return
+	}
+
+	tests := []struct {
+		name           string
+		configured     map[string]bool
+		expression     string
+		wantErrContain string
+	}{{
+		name:           "single valid element",
+		configured:     map[string]bool{"a": true},
+		expression:     `a`,
+		wantErrContain: "",
+	}, {
+		name:           "multiple valid elements",
+		configured:     map[string]bool{"a": true, "b": true},
+		expression:     `a && b`,
+		wantErrContain: "",
+	}, {
+		name:           "missing element",
+		configured:     map[string]bool{"a": true, "b": false},
+		expression:     `a && b`,
+		wantErrContain: fmt.Sprintf("%s: b", ErrMissingIntegrations),
+	}, {
+		name:           "unknown element",
+		configured:     map[string]bool{},
+		expression:     `d`,
+		wantErrContain: ErrInvalidExpression.Error(),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotErr := c.Evaluate(tt.configured, tt.expression)
+			if gotErr != nil {
+				if tt.wantErrContain == "" {
+					t.Errorf("Evaluate() failed: %v", gotErr)
+				}
+				if !strings.Contains(gotErr.Error(), tt.wantErrContain) {
+					t.Errorf("Evaluate() error %q does not contain expected: %q",
+						gotErr, tt.wantErrContain)
+				}
+				return
+			}
+			if tt.wantErrContain != "" {
+				t.Fatal("Evaluate() succeeded unexpectedly")
+			}
+		})
+	}
+}
+
+func TestCEL_Satisfied(t *testing.T) {
+	cfs := chartfs.New(os.DirFS("../../test"))
+	cfg, err := config.NewConfigFromFile(
+		cfs, "config.yaml", "test-namespace", "helmet_ex")
+	if err != nil {
+		t.Fatalf("NewConfigFromFile() failed: %v", err)
+	}
+	c, err := NewCEL("a", "b")
+	if err != nil {
+		t.Fatalf("NewCEL() failed: %v", err)
+	}
+	c.SetConfig(cfg)
+
+	tests := []struct {
+		name       string
+		configured map[string]bool
+		expression string
+		want       bool
+		wantErr    bool
+	}{{
+		name:       "product enabled",
+		expression: `products.Product_D.enabled`,
+		want:       true,
+	}, {
+		name:       "product property",
+		expression: `products.Product_D.properties.authProvider == "oidc"`,
+		want:       true,
+	}, {
+		name:       "missing product guarded",
+		expression: `has(products.Product_Z) && products.Product_Z.enabled`,
+		want:       false,
+	}, {
+		name:       "setting and integration",
+		configured: map[string]bool{"a": true},
+		expression: `!settings.crc && a && !b`,
+		want:       true,
+	}, {
+		name:       "not a boolean",
+		expression: `products.Product_D.namespace`,
+		wantErr:    true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Satisfied(tt.configured, tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Satisfied() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Satisfied() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
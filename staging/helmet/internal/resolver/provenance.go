@@ -0,0 +1,137 @@
+package resolver
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReasonKind why a chart is part of the topology, or why it was left out.
+type ReasonKind string
+
+const (
+	// ReasonProduct the chart of an enabled product.
+	ReasonProduct ReasonKind = "product"
+	// ReasonDependsOn pulled in by a chart depending on it, "depends-on".
+	ReasonDependsOn ReasonKind = "depends-on"
+	// ReasonAttached a chart without product depending on a chart already in the
+	// topology, attached after it.
+	ReasonAttached ReasonKind = "attached"
+	// ReasonSkipped the chart of a disabled product, a chart depends on, skipped.
+	ReasonSkipped ReasonKind = "skipped"
+	// ReasonCondition the chart "condition" isn't met, skipped.
+	ReasonCondition ReasonKind = "condition"
+)
+
+// Reason the provenance of a chart on the topology, recorded by the resolver.
+type Reason struct {
+	Kind ReasonKind `json:"kind"`
+	// Chart the chart depending on it, for "depends-on", "skipped" and
+	// "condition", or the chart it's attached after, for "attached".
+	Chart string `json:"chart,omitempty"`
+	// Product the enabled product, for "product", or the disabled product, for
+	// "skipped".
+	Product string `json:"product,omitempty"`
+	// Condition the CEL expression not met, for "condition".
+	Condition string `json:"condition,omitempty"`
+}
+
+// String describes the reason.
+func (r Reason) String() string {
+	switch r.Kind {
+	case ReasonProduct:
+		return fmt.Sprintf("chart of the enabled product %q", r.Product)
+	case ReasonDependsOn:
+		return fmt.Sprintf("pulled in by %q, depends-on", r.Chart)
+	case ReasonAttached:
+		return fmt.Sprintf("attached after %q, depends on it", r.Chart)
+	case ReasonSkipped:
+		return fmt.Sprintf("skipped for %q, product %q is disabled",
+			r.Chart, r.Product)
+	case ReasonCondition:
+		if r.Chart == "" {
+			return fmt.Sprintf("condition %q is not met", r.Condition)
+		}
+		return fmt.Sprintf("skipped for %q, condition %q is not met",
+			r.Chart, r.Condition)
+	default:
+		return string(r.Kind)
+	}
+}
+
+// record records the reason for the named chart, once.
+func (t *Topology) record(name string, reason Reason) {
+	for _, r := range t.reasons[name] {
+		if r == reason {
+			return
+		}
+	}
+	t.reasons[name] = append(t.reasons[name], reason)
+}
+
+// Reasons returns the reasons the named chart is part of the topology, or was
+// skipped, in the order the resolver recorded them.
+func (t *Topology) Reasons(name string) []Reason {
+	return t.reasons[name]
+}
+
+// Explain writes the provenance chain of the named chart: the reasons it's part
+// of the topology, or was left out, and recursively the reasons of the charts
+// pulling it in, down to the enabled products. The collection holds the charts
+// not part of the topology. Chains already written are not repeated.
+func (t *Topology) Explain(w io.Writer, c *Collection, name string) error {
+	d, err := c.Get(name)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	if i := t.index(name); i >= 0 {
+		fmt.Fprintf(&b, "%s is installed at index %d, on namespace %q:\n",
+			name, i+1, t.dependencies[i].Namespace())
+	} else {
+		fmt.Fprintf(&b, "%s is not installed:\n", name)
+	}
+
+	written := map[string]bool{}
+	var explain func(d *Dependency, depth int)
+	explain = func(d *Dependency, depth int) {
+		indent := strings.Repeat("  ", depth)
+		if written[d.Name()] {
+			fmt.Fprintf(&b, "%s- %s: explained above\n", indent, d.Name())
+			return
+		}
+		written[d.Name()] = true
+		reasons := t.Reasons(d.Name())
+		if len(reasons) == 0 {
+			if product := d.ProductName(); product != "" {
+				fmt.Fprintf(&b, "%s- product %q is disabled\n", indent, product)
+				return
+			}
+			missing := []string{}
+			for _, dependsOn := range d.DependsOn() {
+				if !t.Contains(dependsOn) {
+					missing = append(missing, dependsOn)
+				}
+			}
+			if len(missing) > 0 {
+				fmt.Fprintf(&b, "%s- depends on %s, not installed\n",
+					indent, strings.Join(missing, ", "))
+				return
+			}
+			fmt.Fprintf(&b, "%s- no chart installed depends on it\n", indent)
+			return
+		}
+		for _, r := range reasons {
+			fmt.Fprintf(&b, "%s- %s\n", indent, r)
+			if r.Kind == ReasonProduct || r.Chart == "" {
+				continue
+			}
+			if next, err := c.Get(r.Chart); err == nil {
+				explain(next, depth+1)
+			}
+		}
+	}
+	explain(d, 1)
+	_, err = io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,89 @@
+package resolver
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+
+	o "github.com/onsi/gomega"
+)
+
+func TestTopology_Reasons(t *testing.T) {
+	g := o.NewWithT(t)
+
+	cfs := chartfs.New(os.DirFS("../../test"))
+	cfg, err := config.NewConfigFromFile(
+		cfs, "config.yaml", "test-namespace", "helmet_ex")
+	g.Expect(err).To(o.Succeed())
+	charts, err := cfs.GetAllCharts()
+	g.Expect(err).To(o.Succeed())
+	c, err := NewCollection(api.NewAppContext("helmet-ex"), charts)
+	g.Expect(err).To(o.Succeed())
+
+	t.Run("Resolve", func(t *testing.T) {
+		g := o.NewWithT(t)
+		topology := resolveTopology(g, cfg, c)
+
+		// Only the first chart adding the dependency is recorded, "Product C" is
+		// the first product on the configuration.
+		g.Expect(topology.Reasons("helmet-foundation")).To(o.Equal([]Reason{{
+			Kind: ReasonDependsOn, Chart: "helmet-product-c",
+		}}))
+		g.Expect(topology.Reasons("helmet-product-a")).To(o.Equal([]Reason{{
+			Kind: ReasonProduct, Product: "Product A",
+		}}))
+		g.Expect(topology.Reasons("helmet-integrations")).To(o.Equal([]Reason{{
+			Kind: ReasonAttached, Chart: "helmet-product-b",
+		}}))
+		for _, d := range topology.Dependencies() {
+			g.Expect(topology.Reasons(d.Name())).To(o.HaveLen(1), d.Name())
+		}
+	})
+
+	t.Run("Resolve/disabled product", func(t *testing.T) {
+		g := o.NewWithT(t)
+		cfg, err := config.NewConfigFromFile(
+			cfs, "config.yaml", "test-namespace", "helmet_ex")
+		g.Expect(err).To(o.Succeed())
+		product, err := cfg.GetProduct("Product B")
+		g.Expect(err).To(o.Succeed())
+		product.Enabled = false
+		g.Expect(cfg.SetProduct("Product B", *product)).To(o.Succeed())
+
+		topology := resolveTopology(g, cfg, c)
+		g.Expect(topology.Contains("helmet-product-b")).To(o.BeFalse())
+		g.Expect(topology.Reasons("helmet-product-b")).To(o.Equal([]Reason{{
+			Kind: ReasonSkipped, Chart: "helmet-product-d", Product: "Product B",
+		}, {
+			Kind: ReasonSkipped, Chart: "helmet-integrations", Product: "Product B",
+		}}))
+
+		var b strings.Builder
+		g.Expect(topology.Explain(&b, c, "helmet-product-b")).To(o.Succeed())
+		g.Expect(b.String()).To(o.Equal(`helmet-product-b is not installed:
+  - skipped for "helmet-product-d", product "Product B" is disabled
+    - chart of the enabled product "Product D"
+  - skipped for "helmet-integrations", product "Product B" is disabled
+    - attached after "helmet-product-a", depends on it
+      - chart of the enabled product "Product A"
+`))
+	})
+
+	t.Run("Explain", func(t *testing.T) {
+		g := o.NewWithT(t)
+		topology := resolveTopology(g, cfg, c)
+
+		var b strings.Builder
+		g.Expect(topology.Explain(&b, c, "helmet-networking")).To(o.Succeed())
+		g.Expect(b.String()).To(o.Equal(`helmet-networking is installed at index 4, on namespace "test-namespace":
+  - pulled in by "helmet-product-c", depends-on
+    - chart of the enabled product "Product C"
+`))
+		g.Expect(topology.Explain(&b, c, "helmet-unknown")).
+			To(o.MatchError(ErrDependencyNotFound))
+	})
+}
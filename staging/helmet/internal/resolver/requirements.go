@@ -0,0 +1,200 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+)
+
+// ErrUnsatisfiable no set of integrations satisfies the charts required
+// integrations expressions.
+var ErrUnsatisfiable = errors.New("required integrations can't be satisfied")
+
+// Requirements the integrations the charts of the topology require, besides the
+// integrations provided by the charts themselves, what must be configured before
+// deploying.
+type Requirements struct {
+	Dependencies Dependencies // charts requiring integrations
+	Provided     []string     // integrations provided by the charts
+	Candidates   []string     // integrations referenced, not provided by the charts
+	Configured   []string     // candidates configured
+	// Sets the minimal sets of integrations satisfying every chart, configuring
+	// any of them is enough.
+	Sets [][]string
+	// Groups every group requires one of its integrations, the conjunctive form
+	// of the sets. It's exact for expressions without negations, as the charts
+	// expressions.
+	Groups [][]string
+	// Met the configured integrations satisfy every chart.
+	Met bool
+}
+
+// Missing returns the groups without an integration configured.
+func (r *Requirements) Missing() [][]string {
+	missing := [][]string{}
+	for _, group := range r.Groups {
+		if !slices.ContainsFunc(group, func(name string) bool {
+			return slices.Contains(r.Configured, name)
+		}) {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
+
+// masks returns the bitmasks over n elements, by number of elements set, then
+// ascending.
+func masks(n int) []uint {
+	all := make([]uint, 0, 1<<n)
+	for m := uint(0); m < 1<<n; m++ {
+		all = append(all, m)
+	}
+	slices.SortStableFunc(all, func(a, b uint) int {
+		return bits.OnesCount(a) - bits.OnesCount(b)
+	})
+	return all
+}
+
+// names returns the names selected by the bitmask.
+func names(candidates []string, m uint) []string {
+	selected := []string{}
+	for i, name := range candidates {
+		if m&(1<<i) != 0 {
+			selected = append(selected, name)
+		}
+	}
+	return selected
+}
+
+// sortSets sorts the sets by size, then by names.
+func sortSets(sets [][]string) {
+	slices.SortFunc(sets, func(a, b []string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return slices.Compare(a, b)
+	})
+}
+
+// NewRequirements computes the integrations required by the topology charts.
+// The integrations provided by those charts are considered configured, like the
+// deployment does, the remaining integrations referenced by the expressions are
+// the candidates: the minimal sets of candidates satisfying every chart are
+// searched exhaustively, and summarized as groups.
+func NewRequirements(
+	cfg *config.Config,
+	t *Topology,
+	c *CEL,
+	configured map[string]bool,
+) (*Requirements, error) {
+	r := &Requirements{
+		Dependencies: Dependencies{},
+		Provided:     []string{},
+		Candidates:   []string{},
+		Configured:   []string{},
+		Sets:         [][]string{},
+		Groups:       [][]string{},
+	}
+	for _, d := range t.Dependencies() {
+		r.Provided = append(r.Provided, d.IntegrationsProvided()...)
+	}
+	slices.Sort(r.Provided)
+	r.Provided = slices.Compact(r.Provided)
+
+	for _, d := range t.Dependencies() {
+		if d.IntegrationsRequired() == "" {
+			continue
+		}
+		referenced, err := c.Referenced(d.IntegrationsRequired())
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", d.Name(), err)
+		}
+		for _, name := range referenced {
+			if !slices.Contains(r.Provided, name) {
+				r.Candidates = append(r.Candidates, name)
+			}
+		}
+		r.Dependencies = append(r.Dependencies, d)
+	}
+	slices.Sort(r.Candidates)
+	r.Candidates = slices.Compact(r.Candidates)
+
+	c.SetConfig(cfg)
+	vars := map[string]bool{}
+	for _, name := range r.Provided {
+		vars[name] = true
+	}
+	// satisfies evaluates every chart expression with the candidates selected by
+	// the bitmask configured.
+	satisfies := func(m uint) (bool, error) {
+		for i, name := range r.Candidates {
+			vars[name] = m&(1<<i) != 0
+		}
+		for _, d := range r.Dependencies {
+			ok, err := c.Satisfied(vars, d.IntegrationsRequired())
+			if err != nil {
+				return false, fmt.Errorf("chart %q: %w", d.Name(), err)
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	current := uint(0)
+	for i, name := range r.Candidates {
+		if configured[name] {
+			r.Configured = append(r.Configured, name)
+			current |= 1 << i
+		}
+	}
+	var err error
+	if r.Met, err = satisfies(current); err != nil {
+		return nil, err
+	}
+
+	all := masks(len(r.Candidates))
+	sets := []uint{}
+	for _, m := range all {
+		if slices.ContainsFunc(sets, func(s uint) bool { return m&s == s }) {
+			continue
+		}
+		ok, err := satisfies(m)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			sets = append(sets, m)
+			r.Sets = append(r.Sets, names(r.Candidates, m))
+		}
+	}
+	if len(sets) == 0 {
+		required := make([]string, 0, len(r.Dependencies))
+		for _, d := range r.Dependencies {
+			required = append(required,
+				fmt.Sprintf("%s: %q", d.Name(), d.IntegrationsRequired()))
+		}
+		return nil, fmt.Errorf("%w: %s", ErrUnsatisfiable,
+			strings.Join(required, ", "))
+	}
+	sortSets(r.Sets)
+
+	// The groups are the minimal sets of candidates intersecting every set.
+	groups := []uint{}
+	for _, m := range all {
+		if slices.ContainsFunc(groups, func(g uint) bool { return m&g == g }) {
+			continue
+		}
+		if !slices.ContainsFunc(sets, func(s uint) bool { return m&s == 0 }) {
+			groups = append(groups, m)
+			r.Groups = append(r.Groups, names(r.Candidates, m))
+		}
+	}
+	sortSets(r.Groups)
+	return r, nil
+}
@@ -0,0 +1,73 @@
+package resolver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+
+	o "github.com/onsi/gomega"
+)
+
+func TestNewRequirements(t *testing.T) {
+	g := o.NewWithT(t)
+
+	cfs := chartfs.New(os.DirFS("../../test"))
+	charts, err := cfs.GetAllCharts()
+	g.Expect(err).To(o.Succeed())
+	c, err := NewCollection(api.NewAppContext("helmet-ex"), charts)
+	g.Expect(err).To(o.Succeed())
+	cel, err := NewCEL("acs", "quay", "nexus")
+	g.Expect(err).To(o.Succeed())
+
+	// requirements resolves the topology with the product disabled, when
+	// informed, and computes its requirements.
+	requirements := func(
+		g o.Gomega,
+		disabled string,
+		configured map[string]bool,
+	) (*Requirements, error) {
+		cfg, err := config.NewConfigFromFile(
+			cfs, "config.yaml", "test-namespace", "helmet_ex")
+		g.Expect(err).To(o.Succeed())
+		if disabled != "" {
+			product, err := cfg.GetProduct(disabled)
+			g.Expect(err).To(o.Succeed())
+			product.Enabled = false
+			g.Expect(cfg.SetProduct(disabled, *product)).To(o.Succeed())
+		}
+		return NewRequirements(cfg, resolveTopology(g, cfg, c), cel, configured)
+	}
+
+	t.Run("provided by the charts", func(t *testing.T) {
+		g := o.NewWithT(t)
+		req, err := requirements(g, "", nil)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(req.Provided).To(o.Equal([]string{"acs", "nexus", "quay"}))
+		g.Expect(req.Candidates).To(o.BeEmpty())
+		g.Expect(req.Met).To(o.BeTrue())
+		g.Expect(req.Missing()).To(o.BeEmpty())
+	})
+
+	t.Run("disabled product", func(t *testing.T) {
+		g := o.NewWithT(t)
+		req, err := requirements(g, "Product A", nil)
+		g.Expect(err).To(o.Succeed())
+		g.Expect(req.Candidates).To(o.Equal([]string{"acs"}))
+		g.Expect(req.Sets).To(o.Equal([][]string{{"acs"}}))
+		g.Expect(req.Groups).To(o.Equal([][]string{{"acs"}}))
+		g.Expect(req.Met).To(o.BeFalse())
+		g.Expect(req.Missing()).To(o.Equal([][]string{{"acs"}}))
+	})
+
+	t.Run("disabled product, configured", func(t *testing.T) {
+		g := o.NewWithT(t)
+		req, err := requirements(g, "Product A", map[string]bool{"acs": true})
+		g.Expect(err).To(o.Succeed())
+		g.Expect(req.Configured).To(o.Equal([]string{"acs"}))
+		g.Expect(req.Met).To(o.BeTrue())
+		g.Expect(req.Missing()).To(o.BeEmpty())
+	})
+}
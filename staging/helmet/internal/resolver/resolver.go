@@ -0,0 +1,262 @@
+package resolver
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/redhat-appstudio/helmet/internal/config"
+)
+
+// Resolver represents the actor that resolves dependencies between charts. Charts
+// with the "condition" annotation are only selected when the CEL expression is
+// met, evaluated against the configuration and the configured integrations.
+type Resolver struct {
+	cfg        *config.Config  // installer configuration
+	collection *Collection     // collection of charts
+	topology   *Topology       // topology of dependencies
+	cel        *CEL            // chart conditions environment
+	configured map[string]bool // integrations configured in the cluster
+}
+
+// ErrCircularDependency reports a circular dependency.
+var ErrCircularDependency = fmt.Errorf("circular dependency detected")
+
+// ErrMissingDependency reports an unmet dependency.
+var ErrMissingDependency = fmt.Errorf("unmet dependency detected")
+
+// setDependencyNamespace sets the desired namespace on the informed dependency.
+// By default, charts are deployed on the same namespace than the installer, while
+// product assossiated dependencies will use the namespace configured for it.
+func (r *Resolver) setDependencyNamespace(d *Dependency) error {
+	var product string
+	// Check if the Helm chart should use the product namespace.
+	if p := d.UseProductNamespace(); p != "" {
+		product = p
+	}
+	// Check if the Helm chart is associated with a product, which takes
+	// precedence over the "use-product-namespace" annotation.
+	if p := d.ProductName(); p != "" {
+		product = p
+	}
+
+	// Choosing the namespace for the dependency, a product chart will use what's
+	// defined for it, while regular charts will use the installer's namespace.
+	var namespace string
+	if product == "" {
+		namespace = r.cfg.Namespace()
+	} else {
+		spec, err := r.cfg.GetProduct(product)
+		if err != nil {
+			return err
+		}
+		namespace = *spec.Namespace
+	}
+	d.SetNamespace(namespace)
+	return nil
+}
+
+// conditionMet evaluates the dependency enablement condition, unconditional
+// charts are always met. When not met, the reason is recorded on the topology,
+// for the dependent chart, if any.
+func (r *Resolver) conditionMet(d *Dependency, dependent string) (bool, error) {
+	condition := d.Condition()
+	if condition == "" {
+		return true, nil
+	}
+	met, err := r.cel.Satisfied(r.configured, condition)
+	if err != nil {
+		return false, fmt.Errorf("dependency %q condition: %w", d.Name(), err)
+	}
+	if !met {
+		r.topology.record(d.Name(), Reason{
+			Kind: ReasonCondition, Chart: dependent, Condition: condition,
+		})
+	}
+	return met, nil
+}
+
+// add appends the dependency to the topology, recording the reason when it's a
+// new addition.
+func (r *Resolver) add(d *Dependency, reason Reason) {
+	if !r.topology.Contains(d.Name()) {
+		r.topology.record(d.Name(), reason)
+	}
+	r.topology.Append(*d)
+}
+
+// dependsOn checks if the chart has dependencies and resolves them. The
+// dependencies are added to the topology and when more dependencies are found,
+// they are also resolved. The order is defined by Topology.Sort.
+func (r *Resolver) dependsOn(
+	d *Dependency, // dependency instance
+	visited map[string]bool, // visited charts
+) error {
+	// Ensure the chart is not visited again, to prevent circular dependencies.
+	dependencyName := d.Name()
+	if visited[dependencyName] {
+		return fmt.Errorf("%w: a %q dependency requires %q",
+			ErrCircularDependency, dependencyName, dependencyName)
+	}
+	visited[dependencyName] = true
+	defer delete(visited, dependencyName)
+
+	for _, dependsOn := range d.DependsOn() {
+		// Picking up the dependency from the collection by name.
+		dependsOnDep, err := r.collection.Get(dependsOn)
+		if err != nil {
+			return err
+		}
+		// Skiping when the next dependency is associated with a disabled product.
+		if product := dependsOnDep.ProductName(); product != "" {
+			productSpec, err := r.cfg.GetProduct(product)
+			if err != nil {
+				return err
+			}
+			if !productSpec.Enabled {
+				r.topology.record(dependsOn, Reason{
+					Kind: ReasonSkipped, Chart: dependencyName, Product: product,
+				})
+				continue
+			}
+		}
+		// Skipping when the dependency condition isn't met.
+		met, err := r.conditionMet(dependsOnDep, dependencyName)
+		if err != nil {
+			return err
+		}
+		if !met {
+			continue
+		}
+		// Setting the correct namespace in the dependency.
+		if err := r.setDependencyNamespace(dependsOnDep); err != nil {
+			return err
+		}
+		// Adding the Helm chart to the topology, it's placed before the parent
+		// chart when the topology is sorted.
+		r.add(dependsOnDep, Reason{Kind: ReasonDependsOn, Chart: dependencyName})
+		// Recursively resolving the dependencies.
+		if err = r.dependsOn(dependsOnDep, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveEnabledProducts resolves the dependencies of enabled products.
+func (r *Resolver) resolveEnabledProducts() error {
+	for _, product := range r.cfg.GetEnabledProducts() {
+		d, err := r.collection.GetProductDependency(product.Name)
+		if err != nil {
+			return err
+		}
+		// Skipping the product chart when its condition isn't met.
+		met, err := r.conditionMet(d, "")
+		if err != nil {
+			return err
+		}
+		if !met {
+			continue
+		}
+		// Products uses the namespace specified in the configuration.
+		d.SetNamespace(*product.Namespace)
+		// Product charts are added to the topology before required charts.
+		r.add(d, Reason{Kind: ReasonProduct, Product: product.Name})
+		// Recursively resolving the dependencies.
+		if err = r.dependsOn(d, map[string]bool{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveDependencies final inspection of the Helm charts in the Collection to
+// ensure all dependencies are met. It walks the charts in the Collection, and for
+// each entry verifies it it depends on any chart in the Topology.
+func (r *Resolver) resolveDependencies() error {
+	return r.collection.Walk(func(name string, d Dependency) error {
+		// Skip dependencies that are associated with a product. These have
+		// already been added to the topology.
+		if product := d.ProductName(); product != "" {
+			return nil
+		}
+		// Collecting a dependency name that is required by the current chart
+		// (dependency), if any.
+		requiredDependency := ""
+		for _, dependsOn := range d.DependsOn() {
+			// Ensure the required dependency is in the topology, when not in the
+			// topology it is skipped.
+			if !r.topology.Contains(dependsOn) {
+				continue
+			}
+			// Ensures the required dependency is in the collection.
+			if _, err := r.collection.Get(dependsOn); err != nil {
+				return fmt.Errorf(
+					"%w: dependency %s not found for chart %s",
+					ErrMissingDependency,
+					dependsOn,
+					name,
+				)
+			}
+			requiredDependency = dependsOn
+		}
+		// If there is no required dependency, skip it.
+		if requiredDependency == "" {
+			return nil
+		}
+		// Skipping when the dependency condition isn't met.
+		if met, err := r.conditionMet(&d, requiredDependency); err != nil || !met {
+			return err
+		}
+		// Setting the desired namespace in the dependency.
+		if err := r.setDependencyNamespace(&d); err != nil {
+			return err
+		}
+		// Adding the current dependency to the topology, it's placed after the
+		// ones it requires when the topology is sorted.
+		r.add(&d, Reason{Kind: ReasonAttached, Chart: requiredDependency})
+		// Recursively resolve dependencies.
+		return r.dependsOn(&d, map[string]bool{})
+	})
+}
+
+// SetIntegrations sets the CEL environment, with all known integration names,
+// and the integrations configured in the cluster, the chart conditions are
+// evaluated against. Without it, conditions only reference the configuration.
+func (r *Resolver) SetIntegrations(c *CEL, configured map[string]bool) {
+	r.cel = c
+	r.configured = configured
+}
+
+// Resolve resolves the all dependencies in the collection to create the topology,
+// sorted in the order dependencies are deployed.
+func (r *Resolver) Resolve() error {
+	if r.cel == nil {
+		var err error
+		if r.cel, err = NewCEL(); err != nil {
+			return err
+		}
+	}
+	r.cel.SetConfig(r.cfg)
+	if err := r.resolveEnabledProducts(); err != nil {
+		return err
+	}
+	if err := r.resolveDependencies(); err != nil {
+		return err
+	}
+	return r.topology.Sort()
+}
+
+// Print prints the resolved topology to the writer formatted as a table.
+func (r *Resolver) Print(w io.Writer) {
+	r.topology.Print(w)
+}
+
+// NewResolver instantiates a new Resolver. It takes the configuration, collection
+// and topology as parameters.
+func NewResolver(cfg *config.Config, c *Collection, t *Topology) *Resolver {
+	return &Resolver{
+		cfg:        cfg,
+		collection: c,
+		topology:   t,
+	}
+}
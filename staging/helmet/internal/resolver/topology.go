@@ -0,0 +1,159 @@
+package resolver
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// Topology represents the dependency topology, determines the order in which
+// charts (dependencies) will be installed.
+type Topology struct {
+	dependencies Dependencies        // dependency topology
+	reasons      map[string][]Reason // provenance, by chart name
+}
+
+// Dependencies exposes the list of dependencies.
+func (t *Topology) Dependencies() Dependencies {
+	return t.dependencies
+}
+
+// GetDependency returns the dependency for a given dependency name.
+func (t *Topology) GetDependency(name string) (*Dependency, error) {
+	for i := range t.dependencies {
+		if t.dependencies[i].Name() == name {
+			return &t.dependencies[i], nil
+		}
+	}
+	return nil, fmt.Errorf("dependency %q not found", name)
+}
+
+// index returns the position of the named dependency, -1 when not present.
+func (t *Topology) index(name string) int {
+	for i := range t.dependencies {
+		if t.dependencies[i].Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains checks if a dependency Contains in the topology.
+func (t *Topology) Contains(name string) bool {
+	for _, d := range t.dependencies {
+		if d.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk traverses the topology and calls the informed function for each
+// dependency.
+func (t *Topology) Walk(fn DependencyWalkFn) error {
+	for i := range t.dependencies {
+		if err := fn(t.dependencies[i].Name(), t.dependencies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ready checks whether the dependencies the informed one depends on, present in
+// the topology, are all placed.
+func (t *Topology) ready(d *Dependency, placed map[string]bool) bool {
+	for _, dependsOn := range d.DependsOn() {
+		if t.Contains(dependsOn) && !placed[dependsOn] {
+			return false
+		}
+	}
+	return true
+}
+
+// precedes checks whether the dependency goes before the other, when both are
+// ready: the lowest weight first, then alphabetical order by chart name.
+func precedes(a, b *Dependency) bool {
+	weightA, _ := a.Weight()
+	weightB, _ := b.Weight()
+	if weightA != weightB {
+		return weightA < weightB
+	}
+	return a.Name() < b.Name()
+}
+
+// Sort orders the topology, a topological sort of the "depends-on" relations:
+// dependencies are placed after the ones they depend on, and among the ready
+// dependencies the lowest weight goes first, ties broken by chart name. The
+// order is deterministic, regardless of the order dependencies were added.
+func (t *Topology) Sort() error {
+	pending := slices.Clone(t.dependencies)
+	sorted := make(Dependencies, 0, len(pending))
+	placed := map[string]bool{}
+	for len(pending) > 0 {
+		next := -1
+		for i := range pending {
+			if !t.ready(&pending[i], placed) {
+				continue
+			}
+			if next < 0 || precedes(&pending[i], &pending[next]) {
+				next = i
+			}
+		}
+		// No dependency is ready, the remaining ones depend on each other.
+		if next < 0 {
+			names := make([]string, 0, len(pending))
+			for _, d := range pending {
+				names = append(names, d.Name())
+			}
+			return fmt.Errorf("%w: between %s",
+				ErrCircularDependency, strings.Join(names, ", "))
+		}
+		placed[pending[next].Name()] = true
+		sorted = append(sorted, pending[next])
+		pending = slices.Delete(pending, next, next+1)
+	}
+	t.dependencies = sorted
+	return nil
+}
+
+// Print prints the topology to the writer formatted as a table.
+func (t *Topology) Print(w io.Writer) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	row := func(a ...any) {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", a...)
+	}
+	row("Index", "Dependency", "Namespace", "Product", "Depends-On", "Weight",
+		"Provided-Integrations", "Required-Integrations")
+	for i, d := range t.dependencies {
+		weight, _ := d.Weight()
+		row(
+			fmt.Sprintf("%2d", i+1),
+			d.Name(),
+			d.Namespace(),
+			d.ProductName(),
+			strings.Join(d.DependsOn(), ", "),
+			fmt.Sprintf("%d", weight),
+			strings.Join(d.IntegrationsProvided(), ", "),
+			d.IntegrationsRequired(),
+		)
+	}
+	table.Flush()
+}
+
+// Append adds a new dependency to the end of the topology.
+func (t *Topology) Append(d Dependency) {
+	if t.Contains(d.Name()) {
+		return
+	}
+	t.dependencies = append(t.dependencies, d)
+}
+
+// NewTopology creates a new topology instance.
+func NewTopology() *Topology {
+	return &Topology{
+		dependencies: Dependencies{},
+		reasons:      map[string][]Reason{},
+	}
+}
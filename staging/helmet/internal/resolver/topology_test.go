@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"os"
+	"testing"
+
+	"github.com/redhat-appstudio/helmet/internal/annotations"
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+
+	o "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+// newTestDependency creates a dependency for an in-memory chart, with the
+// informed weight and "depends-on" annotation values.
+func newTestDependency(name, weight, dependsOn string) Dependency {
+	return *NewDependencyWithNamespace(&chart.Chart{
+		Metadata: &chart.Metadata{
+			Name: name,
+			Annotations: map[string]string{
+				annotations.Weight:    weight,
+				annotations.DependsOn: dependsOn,
+			},
+		},
+	}, "default")
+}
+
+// dependencyNames returns the names of the topology dependencies, in order.
+func dependencyNames(topology *Topology) []string {
+	names := []string{}
+	for _, d := range topology.Dependencies() {
+		names = append(names, d.Name())
+	}
+	return names
+}
+
+func TestNewTopology(t *testing.T) {
+	g := o.NewWithT(t)
+
+	cfs := chartfs.New(os.DirFS("../../test"))
+	g.Expect(cfs).ToNot(o.BeNil())
+
+	ns := "default"
+	foundationChart, err := cfs.GetChartFiles("charts/helmet-foundation")
+	g.Expect(err).To(o.Succeed())
+	foundationDep := NewDependencyWithNamespace(foundationChart, ns)
+
+	operatorsChart, err := cfs.GetChartFiles("charts/helmet-operators")
+	g.Expect(err).To(o.Succeed())
+	operatorsDep := NewDependencyWithNamespace(operatorsChart, ns)
+
+	infrastructureChart, err := cfs.GetChartFiles("charts/helmet-infrastructure")
+	g.Expect(err).To(o.Succeed())
+	infrastructureDep := NewDependencyWithNamespace(infrastructureChart, ns)
+
+	networkingChart, err := cfs.GetChartFiles("charts/helmet-networking")
+	g.Expect(err).To(o.Succeed())
+	networkingDep := NewDependencyWithNamespace(networkingChart, ns)
+
+	topology := NewTopology()
+
+	t.Run("Append", func(t *testing.T) {
+		topology.Append(*networkingDep)
+		topology.Append(*infrastructureDep)
+		topology.Append(*operatorsDep)
+		topology.Append(*foundationDep)
+		topology.Append(*networkingDep)
+		g.Expect(topology.Dependencies()).To(o.HaveLen(4))
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		g.Expect(topology.Sort()).To(o.Succeed())
+		g.Expect(dependencyNames(topology)).To(o.Equal([]string{
+			"helmet-foundation",
+			"helmet-operators",
+			"helmet-infrastructure",
+			"helmet-networking",
+		}))
+	})
+
+	t.Run("Sort/weight", func(t *testing.T) {
+		topology := NewTopology()
+		topology.Append(newTestDependency("test-last", "99", ""))
+		topology.Append(newTestDependency("test-b", "", "test-first"))
+		topology.Append(newTestDependency("test-a", "", "test-first"))
+		topology.Append(newTestDependency("test-first", "-10", ""))
+		topology.Append(newTestDependency("test-heavy", "10", ""))
+		g.Expect(topology.Sort()).To(o.Succeed())
+		// The lowest weight goes first among the ready dependencies, the ties
+		// are broken by name, the weight doesn't override "depends-on".
+		g.Expect(dependencyNames(topology)).To(o.Equal([]string{
+			"test-first",
+			"test-a",
+			"test-b",
+			"test-heavy",
+			"test-last",
+		}))
+	})
+
+	t.Run("Sort/circular dependency", func(t *testing.T) {
+		topology := NewTopology()
+		topology.Append(newTestDependency("test-a", "", "test-b"))
+		topology.Append(newTestDependency("test-b", "", "test-a"))
+		topology.Append(newTestDependency("test-c", "", ""))
+		err := topology.Sort()
+		g.Expect(err).To(o.MatchError(ErrCircularDependency))
+		g.Expect(err.Error()).To(o.ContainSubstring("test-a, test-b"))
+	})
+}
@@ -0,0 +1,123 @@
+package resolver
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+)
+
+// TopologyBuilder represents the complete workflow to generate a consolidated
+// Topology, with dependencies and integrations verified.
+type TopologyBuilder struct {
+	logger              *slog.Logger          // application logger
+	collection          *Collection           // charts collection
+	integrationsManager *integrations.Manager // integrations manager
+}
+
+// GetCollection exposes the collection instance.
+func (t *TopologyBuilder) GetCollection() *Collection {
+	return t.collection
+}
+
+// resolve resolves the topology dependencies, the chart conditions are evaluated
+// against the integrations configured in the cluster.
+func (t *TopologyBuilder) resolve(
+	ctx context.Context,
+	cfg *config.Config,
+) (*Topology, *Integrations, error) {
+	// Inspecting the integrations configured in the cluster, the chart
+	// conditions are evaluated against them.
+	t.logger.Debug("Inspecting integrations...")
+	i, err := NewIntegrations(ctx, cfg, t.integrationsManager)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	topology := NewTopology()
+	r := NewResolver(cfg, t.collection, topology)
+	r.SetIntegrations(i.CEL(), i.Configured())
+
+	// Inspecting all charts, dependencies, to organize the topology, which is the
+	// sequence of dependencies deployment.
+	t.logger.Debug("Resolving the topology dependencies...")
+	if err = r.Resolve(); err != nil {
+		return nil, nil, err
+	}
+	return topology, i, nil
+}
+
+// Resolve resolves the topology, based on the cluster configuration, without
+// asserting the required integrations are configured, e.g. to render the values
+// template of a single chart.
+func (t *TopologyBuilder) Resolve(
+	ctx context.Context,
+	cfg *config.Config,
+) (*Topology, error) {
+	topology, _, err := t.resolve(ctx, cfg)
+	return topology, err
+}
+
+// Build inspects the dependencies, based on the cluster configuration, inspects
+// the integrations and generates a consolidated Topology.
+func (t *TopologyBuilder) Build(
+	ctx context.Context,
+	cfg *config.Config,
+) (*Topology, error) {
+	topology, i, err := t.resolve(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Given the Topology is created, now the integrations are verified to ensure
+	// all required integrations secrets are configured.
+	t.logger.Debug("Asserting all required integrations are configured...")
+	if err = i.Inspect(topology); err != nil {
+		return nil, err
+	}
+	return topology, nil
+}
+
+// Requirements resolves the topology, based on the cluster configuration, and
+// computes the integrations to configure before deploying it.
+func (t *TopologyBuilder) Requirements(
+	ctx context.Context,
+	cfg *config.Config,
+) (*Requirements, error) {
+	i, err := NewIntegrations(ctx, cfg, t.integrationsManager)
+	if err != nil {
+		return nil, err
+	}
+	topology := NewTopology()
+	r := NewResolver(cfg, t.collection, topology)
+	r.SetIntegrations(i.CEL(), i.Configured())
+	if err = r.Resolve(); err != nil {
+		return nil, err
+	}
+	return NewRequirements(cfg, topology, i.CEL(), i.Configured())
+}
+
+// NewTopologyBuilder creates a new TopologyBuilder instance.
+func NewTopologyBuilder(
+	appCtx *api.AppContext,
+	logger *slog.Logger,
+	cfs *chartfs.ChartFS,
+	integrationsManager *integrations.Manager,
+) (*TopologyBuilder, error) {
+	t := &TopologyBuilder{
+		logger:              logger,
+		integrationsManager: integrationsManager,
+	}
+	// Reading all charts from the informed filesystem.
+	charts, err := cfs.GetAllCharts()
+	if err != nil {
+		return nil, err
+	}
+	// Creating a collection with the charts found.
+	if t.collection, err = NewCollection(appCtx, charts); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,39 @@
+package runcontext
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/redhat-appstudio/helmet/internal/chartfs"
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+)
+
+// RunContext carries runtime dependencies for command execution: Kubernetes client,
+// chart filesystem, global flags, logger, and the output for the printers and
+// dry-run output.
+type RunContext struct {
+	Kube    k8s.Interface
+	ChartFS *chartfs.ChartFS
+	Flags   *flags.Flags
+	Logger  *slog.Logger
+	Out     io.Writer
+}
+
+// NewRunContext builds a RunContext with the given kube, chart filesystem, global
+// flags, logger and output.
+func NewRunContext(
+	kube k8s.Interface,
+	cfs *chartfs.ChartFS,
+	f *flags.Flags,
+	logger *slog.Logger,
+	out io.Writer,
+) *RunContext {
+	return &RunContext{
+		Kube:    kube,
+		ChartFS: cfs,
+		Flags:   f,
+		Logger:  logger,
+		Out:     out,
+	}
+}
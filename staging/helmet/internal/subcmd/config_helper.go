@@ -0,0 +1,28 @@
+package subcmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+)
+
+// bootstrapConfig retrieves the cluster configuration.
+func bootstrapConfig(ctx context.Context, appCtx *api.AppContext, runCtx *runcontext.RunContext) (*config.Config, error) {
+	mgr := config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance)
+	cfg, err := mgr.GetConfig(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, `
+Unable to find the configuration in the cluster, or the configuration is invalid.
+Please refer to the subcommand "%s config" to manage installer's
+configuration for the target cluster.
+
+	$ %s config --help
+		`, appCtx.Name, appCtx.Name)
+	}
+	return cfg, err
+}
@@ -0,0 +1,210 @@
+package subcmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/flags"
+	"github.com/redhat-appstudio/helmet/internal/installer"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+	"github.com/redhat-appstudio/helmet/internal/k8s"
+	"github.com/redhat-appstudio/helmet/internal/resolver"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"github.com/spf13/cobra"
+)
+
+// Deploy is the deploy subcommand.
+type Deploy struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+	flags  *flags.Flags
+	cfg    *config.Config // installer configuration
+
+	manager            *integrations.Manager     // integration manager
+	topologyBuilder    *resolver.TopologyBuilder // topology builder
+	chartPath          string                    // single chart path
+	valuesTemplatePath string                    // values template file path
+	installerTarball   []byte                    // embedded installer tarball
+}
+
+var _ api.SubCommand = (*Deploy)(nil)
+
+// Cmd exposes the cobra instance.
+func (d *Deploy) Cmd() *cobra.Command {
+	return d.cmd
+}
+
+// log logger with contextual information.
+func (d *Deploy) log() *slog.Logger {
+	return d.flags.LoggerWith(d.runCtx.Logger.With(
+		"chart-path", d.chartPath,
+		flags.ValuesTemplateFlag, d.valuesTemplatePath,
+	))
+}
+
+// Complete verifies the object is complete.
+func (d *Deploy) Complete(args []string) error {
+	var err error
+	d.topologyBuilder, err = resolver.NewTopologyBuilder(
+		d.appCtx, d.runCtx.Logger, d.runCtx.ChartFS, d.manager)
+	if err != nil {
+		return err
+	}
+	d.cfg, err = bootstrapConfig(d.cmd.Context(), d.appCtx, d.runCtx)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		d.chartPath = args[0]
+	}
+	return nil
+}
+
+// Validate asserts the requirements to start the deployment are in place.
+func (d *Deploy) Validate() error {
+	if d.topologyBuilder == nil {
+		panic("topology is nil")
+	}
+	return nil
+}
+
+// Run deploys the enabled dependencies listed on the configuration.
+func (d *Deploy) Run() error {
+	d.log().Debug("Reading values template file")
+	valuesTmpl, err := d.runCtx.ChartFS.ReadFile(d.valuesTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	topology, err := d.topologyBuilder.Build(d.cmd.Context(), d.cfg)
+	if err != nil {
+		if errors.Is(err, resolver.ErrMissingIntegrations) ||
+			errors.Is(err, resolver.ErrPrerequisiteIntegration) {
+			return fmt.Errorf(`%w
+
+Required integrations are missing from the cluster, run the "%s integration"
+subcommand to configure them. For example:
+
+	$ %s integration --help
+	$ %s integration <name> --help
+	`,
+				err, d.appCtx.Name, d.appCtx.Name, d.appCtx.Name)
+		}
+		return err
+	}
+
+	var deps resolver.Dependencies
+	if d.chartPath == "" {
+		d.log().Debug("Installing all dependencies...")
+		deps = topology.Dependencies()
+	} else {
+		d.log().Debug("Installing a single Helm chart...")
+		hc, err := d.runCtx.ChartFS.GetChartFiles(d.chartPath)
+		if err != nil {
+			return err
+		}
+		dep, err := topology.GetDependency(hc.Name())
+		if err != nil {
+			return err
+		}
+		deps = append(deps, *dep)
+	}
+
+	for index, dep := range deps {
+		fmt.Fprintf(d.runCtx.Out, "\n\n%s\n", strings.Repeat("#", 60))
+		fmt.Fprintf(
+			d.runCtx.Out,
+			"# [%d/%d] Deploying '%s' in '%s'.\n",
+			index+1,
+			len(deps),
+			dep.Name(),
+			dep.Namespace(),
+		)
+		fmt.Fprintf(d.runCtx.Out, "%s\n", strings.Repeat("#", 60))
+
+		i := installer.NewInstaller(d.log(), d.runCtx.Out, d.flags, d.runCtx.Kube, &dep, d.installerTarball)
+
+		ctx := d.cmd.Context()
+		err := i.SetValues(ctx, d.cfg, topology, string(valuesTmpl))
+		if err != nil {
+			return err
+		}
+		if d.flags.Debug {
+			i.PrintRawValues()
+		}
+
+		if err := i.RenderValues(); err != nil {
+			return err
+		}
+		if d.flags.Debug {
+			i.PrintValues()
+		}
+
+		if err = i.Install(ctx); err != nil {
+			return err
+		}
+		// Cleaning up temporary resources.
+		if err = k8s.RetryDeleteResources(
+			ctx,
+			d.runCtx.Kube,
+			d.cfg.Namespace(),
+		); err != nil {
+			d.log().Debug(err.Error())
+		}
+		fmt.Fprintf(d.runCtx.Out, "%s\n", strings.Repeat("#", 60))
+	}
+
+	fmt.Fprintf(d.runCtx.Out, "Deployment complete!\n")
+	return nil
+}
+
+// NewDeploy instantiates the deploy subcommand.
+func NewDeploy(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	f *flags.Flags,
+	manager *integrations.Manager,
+	installerTarball []byte,
+) api.SubCommand {
+	deployDesc := fmt.Sprintf(`
+Deploys the %s platform components.
+
+The installer looks at the configuration to identify the products to be
+installed, and the dependencies to be resolved.
+
+The deployment configuration file describes the sequence of Helm charts to be
+applied, on the attribute '%s.dependencies[]'.
+
+The platform configuration is rendered from the values template file
+(--values-template), this configuration payload is given to all Helm charts.
+
+The installer resources are embedded in the executable, these resources are
+employed by default.
+
+A single chart can be deployed by specifying its path. E.g.:
+	%s deploy charts/%s-openshift
+`, appCtx.Name, appCtx.IdentifierName(), appCtx.Name, appCtx.IdentifierName())
+
+	d := &Deploy{
+		cmd: &cobra.Command{
+			Use:          "deploy [chart]",
+			Short:        fmt.Sprintf("Rollout %s platform components", appCtx.Name),
+			Long:         deployDesc,
+			SilenceUsage: true,
+		},
+		appCtx:           appCtx,
+		runCtx:           runCtx,
+		flags:            f,
+		manager:          manager,
+		chartPath:        "",
+		installerTarball: installerTarball,
+	}
+	flags.SetValuesTmplFlag(d.cmd.PersistentFlags(), &d.valuesTemplatePath)
+	return d
+}
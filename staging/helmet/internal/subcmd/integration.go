@@ -0,0 +1,157 @@
+package subcmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/integration"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+	"github.com/redhat-appstudio/helmet/internal/resolver"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"github.com/spf13/cobra"
+)
+
+// disableProductForIntegration disables the product that provides the active
+// integration, if the integration secret exists and the product is currently
+// enabled. Only the active integration is inspected; other integrations are
+// not touched.
+func disableProductForIntegration(
+	ctx context.Context,
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	manager *integrations.Manager,
+	cfg *config.Config,
+	activeIntegration integrations.IntegrationName,
+) error {
+	// Check if THIS integration's secret was actually created.
+	integration := manager.Integration(activeIntegration)
+	exists, err := integration.Exists(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	// Find the product that provides this integration (if any).
+	charts, err := runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	collection, err := resolver.NewCollection(appCtx, charts)
+	if err != nil {
+		return err
+	}
+	productName := collection.GetProductNameForIntegration(
+		string(activeIntegration))
+	if productName == "" {
+		return nil // no product provides this integration
+	}
+
+	spec, err := cfg.GetProduct(productName)
+	if err != nil {
+		return err
+	}
+	if !spec.Enabled {
+		return nil // already disabled
+	}
+
+	spec.Enabled = false
+	if err := cfg.SetProduct(productName, *spec); err != nil {
+		return err
+	}
+	return config.NewConfigMapManager(
+		runCtx.Kube, appCtx.Name, runCtx.Flags.Instance).
+		Update(ctx, cfg)
+}
+
+// showEndpoints shows the endpoints the active integration module declares, the
+// URLs the user configures on the external service. Endpoints that can't be
+// resolved are logged, the integration is already stored at this point.
+func showEndpoints(
+	ctx context.Context,
+	runCtx *runcontext.RunContext,
+	manager *integrations.Manager,
+	cfg *config.Config,
+	activeIntegration integrations.IntegrationName,
+) {
+	i := slices.IndexFunc(manager.GetModules(), func(m api.IntegrationModule) bool {
+		return m.Name == string(activeIntegration)
+	})
+	if i < 0 || manager.GetModules()[i].Endpoints == nil {
+		return
+	}
+	if err := integration.PrintEndpoints(
+		ctx,
+		runCtx.Out,
+		string(activeIntegration),
+		manager.GetModules()[i].Endpoints,
+		runCtx,
+		cfg,
+	); err != nil {
+		runCtx.Logger.Warn("Unable to resolve the integration endpoints",
+			"integration", activeIntegration, "error", err)
+	}
+}
+
+func NewIntegration(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	manager *integrations.Manager,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "integration <type>",
+		Short: fmt.Sprintf(
+			"Configures an external service provider for %s", appCtx.Name,
+		),
+		PersistentPostRunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			// cmd is the child command (e.g., "acs", "quay").
+			// cmd.Name() returns the integration name, matching the
+			// IntegrationName used to register the module in Manager.
+			activeIntegration := integrations.IntegrationName(cmd.Name())
+			// Subcommands the application adds, which aren't integration
+			// modules, have no integration to inspect.
+			if !slices.Contains(
+				manager.IntegrationNames(), string(activeIntegration)) {
+				return nil
+			}
+
+			cfg, err := bootstrapConfig(ctx, appCtx, runCtx)
+			if err != nil {
+				return err
+			}
+			if err := disableProductForIntegration(
+				ctx, appCtx, runCtx, manager, cfg, activeIntegration,
+			); err != nil {
+				return err
+			}
+			showEndpoints(ctx, runCtx, manager, cfg, activeIntegration)
+			return nil
+		},
+	}
+
+	for _, mod := range manager.GetModules() {
+		wrapper := manager.Integration(integrations.IntegrationName(mod.Name))
+		sub := mod.Command(appCtx, runCtx, wrapper)
+		runner := api.NewRunner(sub)
+
+		// Enforce: Cobra command name must match the integration module
+		// name. When they differ, preserve the original as an alias for
+		// backward compatibility.
+		childCmd := runner.Cmd()
+		if childCmd.Name() != mod.Name {
+			childCmd.Aliases = append(childCmd.Aliases, childCmd.Name())
+			childCmd.Use = mod.Name
+		}
+
+		cmd.AddCommand(childCmd)
+	}
+
+	return cmd
+}
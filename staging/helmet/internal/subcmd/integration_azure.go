@@ -0,0 +1,81 @@
+package subcmd
+
+import (
+	"fmt"
+
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/integration"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"github.com/spf13/cobra"
+)
+
+// IntegrationAzure is the sub-command for the "integration azure",
+// responsible for creating and updating the Azure integration secret.
+type IntegrationAzure struct {
+	cmd         *cobra.Command           // cobra command
+	appCtx      *api.AppContext          // application context
+	runCtx      *runcontext.RunContext   // run context (kube, logger, chartfs)
+	cfg         *config.Config           // installer configuration
+	integration *integration.Integration // integration instance
+}
+
+var _ api.SubCommand = &IntegrationAzure{}
+
+// Cmd exposes the cobra instance.
+func (a *IntegrationAzure) Cmd() *cobra.Command {
+	return a.cmd
+}
+
+// Complete is a no-op in this case.
+func (a *IntegrationAzure) Complete(_ []string) error {
+	var err error
+	a.cfg, err = bootstrapConfig(a.cmd.Context(), a.appCtx, a.runCtx)
+	return err
+}
+
+// Validate checks if the required configuration is set.
+func (a *IntegrationAzure) Validate() error {
+	return a.integration.Validate()
+}
+
+// Run creates or updates the Azure integration secret.
+func (a *IntegrationAzure) Run() error {
+	return a.integration.Create(a.cmd.Context(), a.runCtx, a.cfg)
+}
+
+// NewIntegrationAzure creates the sub-command for the "integration azure"
+// responsible to manage the integrations with the Azure service.
+func NewIntegrationAzure(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	i *integration.Integration,
+) *IntegrationAzure {
+	a := &IntegrationAzure{
+		cmd: &cobra.Command{
+			Use: "azure [flags]",
+			Short: fmt.Sprintf(
+				"Integrates an Azure instance into %s",
+				appCtx.Name,
+			),
+			Long: fmt.Sprintf(`
+Manages the Azure integration with %s by storing the credentials
+required by %s services to interact with Azure.
+
+The credentials are stored in a Kubernetes Secret in the namespace
+configured for %s.`,
+				appCtx.Name,
+				appCtx.Name,
+				appCtx.Name,
+			),
+			SilenceUsage: true,
+		},
+
+		appCtx:      appCtx,
+		runCtx:      runCtx,
+		integration: i,
+	}
+	i.PersistentFlags(a.cmd)
+	return a
+}
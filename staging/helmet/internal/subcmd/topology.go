@@ -0,0 +1,103 @@
+package subcmd
+
+import (
+	"github.com/redhat-appstudio/helmet/api"
+	"github.com/redhat-appstudio/helmet/internal/config"
+	"github.com/redhat-appstudio/helmet/internal/integrations"
+	"github.com/redhat-appstudio/helmet/internal/resolver"
+	"github.com/redhat-appstudio/helmet/internal/runcontext"
+
+	"github.com/spf13/cobra"
+)
+
+// Topology represents the topology subcommand, it reports the installer
+// dependency topology based on the cluster configuration and Helm charts.
+type Topology struct {
+	cmd    *cobra.Command // cobra command
+	appCtx *api.AppContext
+	runCtx *runcontext.RunContext
+
+	manager    *integrations.Manager // integrations manager
+	collection *resolver.Collection  // chart collection
+	cfg        *config.Config        // installer configuration
+}
+
+var _ api.SubCommand = (*Topology)(nil)
+
+const topologyDesc = `
+Report the dependency topology of the installer based on the cluster configuration
+and Helm charts. It will output a table with the following columns: 
+
+  - Index: the index of the chart in the dependency graph.
+  - Dependency: the name of the Helm chart.
+  - Namespace: the OpenShift namespace where the chart is installed.
+  - Product: the name of the product the chart is associated with.
+  - Depends-On: comma-separated list of charts the chart depends on.
+  - Provided-Integrations: comma-separated integrations provided by the chart.
+  - Required-Integrations: CEL expressions with the required integrations.
+`
+
+// Cmd exposes the cobra instance.
+func (t *Topology) Cmd() *cobra.Command {
+	return t.cmd
+}
+
+// Complete instantiates the cluster configuration and charts.
+func (t *Topology) Complete(_ []string) error {
+	charts, err := t.runCtx.ChartFS.GetAllCharts()
+	if err != nil {
+		return err
+	}
+	if t.collection, err = resolver.NewCollection(t.appCtx, charts); err != nil {
+		return err
+	}
+	if t.cfg, err = bootstrapConfig(t.cmd.Context(), t.appCtx, t.runCtx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates the command.
+func (t *Topology) Validate() error {
+	return nil
+}
+
+// Run resolves the dependency graph.
+func (t *Topology) Run() error {
+	// Resolving the dependency topology based on the installer configuration and
+	// Helm charts.
+	r := resolver.NewResolver(t.cfg, t.collection, resolver.NewTopology())
+	// Evaluating the chart conditions against the integrations configured in the
+	// cluster, the same as deploy.
+	i, err := resolver.NewIntegrations(t.cmd.Context(), t.cfg, t.manager)
+	if err != nil {
+		return err
+	}
+	r.SetIntegrations(i.CEL(), i.Configured())
+	if err := r.Resolve(); err != nil {
+		return err
+	}
+	// Printing the resolved dependency to the standard output.
+	r.Print(t.runCtx.Out)
+	return nil
+}
+
+// NewTopology instantiates a new Topology subcommand.
+func NewTopology(
+	appCtx *api.AppContext,
+	runCtx *runcontext.RunContext,
+	manager *integrations.Manager,
+) *Topology {
+	t := &Topology{
+		cmd: &cobra.Command{
+			Use:          "topology",
+			Short:        "Shows the installer topology",
+			Long:         topologyDesc,
+			SilenceUsage: true,
+		},
+		appCtx:  appCtx,
+		runCtx:  runCtx,
+		manager: manager,
+	}
+	return t
+}
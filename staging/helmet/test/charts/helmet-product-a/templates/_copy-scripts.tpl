@@ -0,0 +1 @@
+../../_common/_copy-scripts.tpl
\ No newline at end of file
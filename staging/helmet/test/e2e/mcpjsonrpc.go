@@ -0,0 +1,53 @@
+package e2e
+
+import (
+	"encoding/json"
+)
+
+// jsonRPCRequest is a JSON-RPC 2.0 request envelope.
+type jsonRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+// jsonRPCNotification is a JSON-RPC 2.0 notification (no id, no response).
+type jsonRPCNotification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+}
+
+// jsonRPCError represents a JSON-RPC 2.0 error object.
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// jsonRPCResponse is a JSON-RPC 2.0 response envelope.
+// The Result field is decoded separately based on the method.
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int64           `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+// callToolParams holds the parameters for a tools/call request.
+type callToolParams struct {
+	Name      string         `json:"name"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+// clientInfo identifies the MCP client in the initialize handshake.
+type clientInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// initializeParams holds the parameters for the initialize request.
+type initializeParams struct {
+	ProtocolVersion string     `json:"protocolVersion"`
+	ClientInfo      clientInfo `json:"clientInfo"`
+	Capabilities    struct{}   `json:"capabilities"`
+}
@@ -0,0 +1,59 @@
+---
+debug:
+  ci: {{ dig "ci" "debug" false .Installer.Settings }}
+
+################################################################################
+# HELMET FOUNDATION
+################################################################################
+helmet_foundation:
+  projects:
+{{- range .Installer.Products }}
+  {{- if (and .Enabled .Namespace) }}
+      - {{ .Namespace }}
+  {{- end }}
+{{- end }}
+
+################################################################################
+# HELMET INFRASTRUCTURE
+################################################################################
+helmet_infrastructure: {}
+
+################################################################################
+# HELMET INTEGRATIONS
+################################################################################
+helmet_integrations: {}
+
+################################################################################
+# HELMET NETWORKING
+################################################################################
+helmet_networking: {}
+
+################################################################################
+# HELMET OPERATORS
+################################################################################
+helmet_storage: {}
+
+################################################################################
+# HELMET STORAGE
+################################################################################
+helmet_storage: {}
+
+################################################################################
+# PRODUCT A
+################################################################################
+product_a: {}
+
+################################################################################
+# PRODUCT B
+################################################################################
+product_b: {}
+
+################################################################################
+# PRODUCT C
+################################################################################
+product_c: {}
+
+################################################################################
+# PRODUCT D
+################################################################################
+product_d: {}